@@ -14,6 +14,8 @@ func init() {
 	utils.InitDB()
 	utils.InitValidator()
 	utils.InitMailer()
+	utils.InitStorage()
+	utils.InitGithub()
 }
 
 func main() {
@@ -37,6 +39,8 @@ func main() {
 	router.AuthRoutes(apiGroup)
 	router.PanelRoutes(apiGroup)
 	router.InfoRoutes(apiGroup)
+	router.GalleryRoutes(apiGroup)
+	router.TrackRoutes(apiGroup)
 
 	e.Start(":" + utils.Config.Port)
 }
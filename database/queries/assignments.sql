@@ -0,0 +1,68 @@
+-- name: CreatePanelAssignment :exec
+INSERT INTO panel_assignments (
+    id, round, panel_id, team_id, assigned_by, is_auto
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+)
+ON CONFLICT (round, panel_id, team_id) DO UPDATE
+SET is_auto = panel_assignments.is_auto AND EXCLUDED.is_auto;
+
+-- name: DeletePanelAssignment :exec
+DELETE FROM panel_assignments
+WHERE round = $1 AND panel_id = $2 AND team_id = $3;
+
+-- name: DeleteRoundAssignments :exec
+DELETE FROM panel_assignments a
+WHERE a.round = @round
+  AND (a.is_auto OR @replace_manual::BOOLEAN)
+  AND NOT EXISTS (
+      SELECT 1 FROM score s
+      WHERE s.team_id = a.team_id AND s.round = a.round AND s.panel_id = a.panel_id
+  );
+
+-- name: IsPanelAssigned :one
+SELECT EXISTS (
+    SELECT 1 FROM panel_assignments
+    WHERE round = $1 AND panel_id = $2 AND team_id = $3
+) AS is_assigned;
+
+-- name: GetRoundAssignments :many
+SELECT a.round, a.panel_id, u.first_name, u.last_name, u.email, a.team_id, t.name, a.created_at
+FROM panel_assignments a
+JOIN users u ON u.id = a.panel_id
+JOIN teams t ON t.id = a.team_id
+WHERE a.round = $1
+ORDER BY u.email, t.name;
+
+-- name: GetAssignableTeams :many
+SELECT t.id, t.name,
+    COALESCE(s.track, (SELECT i.track FROM ideas i WHERE i.team_id = t.id LIMIT 1), '')::TEXT AS track
+FROM teams t
+LEFT JOIN submission s ON s.team_id = t.id AND s.round = @round::INTEGER
+WHERE NOT t.is_banned
+  AND GREATEST(COALESCE(t.round_qualified, 0), 1) >= @round::INTEGER
+ORDER BY track, t.id;
+
+-- name: GetPanelQueue :many
+SELECT a.round, a.team_id, t.name,
+    COALESCE(s.track, (SELECT i.track FROM ideas i WHERE i.team_id = t.id LIMIT 1), '')::TEXT AS track,
+    s.id AS submission_id,
+    EXISTS (
+        SELECT 1 FROM score sc
+        WHERE sc.team_id = a.team_id AND sc.round = a.round AND sc.panel_id = a.panel_id
+    ) AS scored
+FROM panel_assignments a
+JOIN teams t ON t.id = a.team_id
+LEFT JOIN submission s ON s.team_id = a.team_id AND s.round = a.round
+WHERE a.panel_id = @panel_id
+  AND (@round::INTEGER = 0 OR a.round = @round::INTEGER)
+ORDER BY a.round, scored, t.name;
+
+-- name: DeleteTeamAssignments :exec
+DELETE FROM panel_assignments
+WHERE team_id = $1;
+
+-- name: TransferAssignments :exec
+UPDATE panel_assignments
+SET team_id = @target_team_id
+WHERE team_id = @source_team_id;
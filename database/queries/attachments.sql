@@ -0,0 +1,40 @@
+-- name: CreateSubmissionAttachment :one
+INSERT INTO submission_attachments (
+    id, team_id, round, file_name, content_type, size, storage_key, uploaded_by
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8
+)
+RETURNING *;
+
+-- name: GetSubmissionAttachments :many
+SELECT * FROM submission_attachments
+WHERE team_id = $1 AND round = $2
+ORDER BY created_at;
+
+-- name: GetSubmissionAttachment :one
+SELECT * FROM submission_attachments
+WHERE id = $1;
+
+-- name: GetTeamAttachmentUsage :one
+SELECT COALESCE(SUM(size), 0)::BIGINT AS used
+FROM submission_attachments
+WHERE team_id = $1;
+
+-- name: DeleteSubmissionAttachment :exec
+DELETE FROM submission_attachments
+WHERE id = $1;
+
+-- name: DeleteSubmissionAttachmentsByTeam :many
+DELETE FROM submission_attachments
+WHERE team_id = $1 AND round = $2
+RETURNING storage_key;
+
+-- name: DeleteTeamAttachments :many
+DELETE FROM submission_attachments
+WHERE team_id = $1
+RETURNING storage_key;
+
+-- name: TransferAttachments :exec
+UPDATE submission_attachments
+SET team_id = @target_team_id
+WHERE team_id = @source_team_id;
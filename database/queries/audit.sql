@@ -0,0 +1,16 @@
+-- name: CreateAuditLog :exec
+INSERT INTO audit_logs (
+    id, actor_id, action, team_id, target_id, details
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+);
+
+-- name: GetAuditLogs :many
+SELECT * FROM audit_logs
+ORDER BY created_at DESC
+LIMIT $1;
+
+-- name: GetAuditLogsByTeam :many
+SELECT * FROM audit_logs
+WHERE team_id = $1
+ORDER BY created_at DESC;
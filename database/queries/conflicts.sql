@@ -0,0 +1,69 @@
+-- name: CreatePanelConflict :one
+INSERT INTO panel_conflicts (
+    id, panel_id, team_id, user_id, reason
+) VALUES (
+    $1, $2, $3, $4, $5
+)
+RETURNING *;
+
+-- name: DeletePanelConflict :execrows
+DELETE FROM panel_conflicts
+WHERE id = $1 AND panel_id = $2;
+
+-- name: GetPanelConflicts :many
+SELECT c.id, c.team_id, t.name AS team_name, c.user_id, u.first_name, u.last_name, u.email, c.reason, c.created_at
+FROM panel_conflicts c
+LEFT JOIN teams t ON t.id = c.team_id
+LEFT JOIN users u ON u.id = c.user_id
+WHERE c.panel_id = $1
+ORDER BY c.created_at DESC;
+
+-- name: DeleteConflictedAssignments :execrows
+DELETE FROM panel_assignments a
+USING panel_conflicts c
+LEFT JOIN users u ON u.id = c.user_id
+WHERE c.id = $1
+  AND a.panel_id = c.panel_id
+  AND a.team_id = COALESCE(c.team_id, u.team_id);
+
+-- name: GetTeamConflicts :many
+SELECT 'declared'::TEXT AS reason, c.reason AS detail
+FROM panel_conflicts c
+WHERE c.panel_id = @panel_id AND c.team_id = @team_id
+UNION ALL
+SELECT 'declared_user'::TEXT, u.first_name || ' ' || u.last_name
+FROM panel_conflicts c
+JOIN users u ON u.id = c.user_id
+WHERE c.panel_id = @panel_id AND u.team_id = @team_id
+UNION ALL
+SELECT 'reg_no_prefix'::TEXT, m.first_name || ' ' || m.last_name
+FROM users p
+JOIN users m ON m.id <> p.id
+WHERE p.id = @panel_id AND m.team_id = @team_id
+  AND @prefix_len::INTEGER > 0
+  AND LENGTH(p.reg_no) >= @prefix_len::INTEGER
+  AND UPPER(LEFT(p.reg_no, @prefix_len::INTEGER)) = UPPER(LEFT(m.reg_no, @prefix_len::INTEGER));
+
+-- name: ListConflictPairs :many
+SELECT p.id AS panel_id, p.email AS panel_email, t.id AS team_id, t.name AS team_name, c.reason
+FROM (
+    SELECT pc.panel_id, pc.team_id, 'declared'::TEXT AS reason
+    FROM panel_conflicts pc
+    WHERE pc.team_id IS NOT NULL
+    UNION
+    SELECT pc.panel_id, u.team_id, 'declared_user'::TEXT
+    FROM panel_conflicts pc
+    JOIN users u ON u.id = pc.user_id
+    WHERE u.team_id IS NOT NULL
+    UNION
+    SELECT pu.id, m.team_id, 'reg_no_prefix'::TEXT
+    FROM users pu
+    JOIN users m ON m.id <> pu.id AND m.team_id IS NOT NULL
+    WHERE pu.role = 'panel'
+      AND @prefix_len::INTEGER > 0
+      AND LENGTH(pu.reg_no) >= @prefix_len::INTEGER
+      AND UPPER(LEFT(pu.reg_no, @prefix_len::INTEGER)) = UPPER(LEFT(m.reg_no, @prefix_len::INTEGER))
+) c
+JOIN users p ON p.id = c.panel_id
+JOIN teams t ON t.id = c.team_id
+ORDER BY p.email, t.name, c.reason;
@@ -0,0 +1,23 @@
+-- name: CreateIdeaComment :one
+INSERT INTO idea_comments (
+    id, idea_id, author_id, body, internal
+) VALUES (
+    $1, $2, $3, $4, $5
+)
+RETURNING *;
+
+-- name: GetIdeaComments :many
+SELECT c.id, c.body, c.internal, c.created_at, u.first_name, u.last_name, u.role
+FROM idea_comments c
+LEFT JOIN users u ON u.id = c.author_id
+WHERE c.idea_id = @idea_id
+  AND (@include_internal::BOOLEAN OR NOT c.internal)
+ORDER BY c.created_at;
+
+-- name: GetIdeaCommentStaffEmails :many
+SELECT DISTINCT u.email
+FROM idea_comments c
+JOIN users u ON u.id = c.author_id
+WHERE c.idea_id = @idea_id
+  AND u.role IN ('admin', 'panel')
+  AND u.id <> @author_id;
@@ -0,0 +1,15 @@
+-- name: CreateIdeaRevision :exec
+INSERT INTO idea_revisions (
+    id, idea_id, revision, title, description, track, author_id
+)
+SELECT $1, $2, COALESCE(MAX(revision), 0) + 1, $3, $4, $5, $6
+FROM idea_revisions
+WHERE idea_id = $2;
+
+-- name: GetIdeaRevisions :many
+SELECT r.id, r.revision, r.title, r.description, r.track, r.author_id, r.created_at,
+    u.first_name, u.last_name, u.email
+FROM idea_revisions r
+LEFT JOIN users u ON u.id = r.author_id
+WHERE r.idea_id = $1
+ORDER BY r.revision;
@@ -28,7 +28,7 @@ DELETE FROM ideas
 WHERE id = $1;
 
 -- name: GetIdeaByTeamID :one
-SELECT id, title, description, track
+SELECT id, title, description, track, status, review_note
 FROM ideas
 WHERE team_id = $1
 LIMIT 1;
@@ -42,16 +42,27 @@ LIMIT $2;
 -- name: GetIdeasByTrack :many
 SELECT * FROM ideas
 WHERE 
-    (title ilike '%'||$1||'%' OR track ilike '%'||$1||'%')
+    (title ilike '%'||$1||'%' OR track ilike '%'||$1||'%' OR track = $4::TEXT)
     AND id > $2::UUID  -- Explicit UUID comparison
 ORDER BY id
 LIMIT $3::INTEGER;  -- Explicit INTEGER for limit
 
+-- name: DeleteIdeaByTeamID :exec
+DELETE FROM ideas
+WHERE team_id = $1;
 
+-- name: TransferIdea :exec
+UPDATE ideas
+SET team_id = @target_team_id,
+    updated_at = CURRENT_TIMESTAMP
+WHERE team_id = @source_team_id;
 
-
-
-
-
-
-
+-- name: ReviewIdeas :many
+UPDATE ideas
+SET status = @status,
+    is_selected = (@status::TEXT = 'selected'),
+    review_note = @review_note,
+    reviewed_by = @reviewed_by,
+    reviewed_at = CURRENT_TIMESTAMP
+WHERE id = ANY(@ids::UUID[])
+RETURNING id, team_id, title, track;
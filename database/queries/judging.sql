@@ -0,0 +1,46 @@
+-- name: ListJudgingRounds :many
+SELECT * FROM judging_rounds
+ORDER BY round;
+
+-- name: UpsertJudgingRound :one
+INSERT INTO judging_rounds (round, normalisation)
+VALUES ($1, $2)
+ON CONFLICT (round) DO UPDATE
+SET normalisation = EXCLUDED.normalisation,
+    updated_at = CURRENT_TIMESTAMP
+RETURNING *;
+
+-- name: GetJudgingRound :one
+SELECT * FROM judging_rounds
+WHERE round = $1;
+
+-- name: SetJudgingRoundState :one
+INSERT INTO judging_rounds (round, state, finalised_at, reopen_reason, state_changed_by)
+VALUES ($1, $2, CURRENT_TIMESTAMP, $3, $4)
+ON CONFLICT (round) DO UPDATE
+SET state = EXCLUDED.state,
+    finalised_at = CASE EXCLUDED.state
+        WHEN 'open' THEN NULL
+        WHEN 'locked' THEN COALESCE(judging_rounds.finalised_at, CURRENT_TIMESTAMP)
+        ELSE judging_rounds.finalised_at
+    END,
+    published_at = CASE WHEN EXCLUDED.state = 'published' THEN CURRENT_TIMESTAMP END,
+    reopen_reason = COALESCE(EXCLUDED.reopen_reason, judging_rounds.reopen_reason),
+    state_changed_by = EXCLUDED.state_changed_by,
+    updated_at = CURRENT_TIMESTAMP
+RETURNING *;
+
+-- name: EnsureJudgingRound :exec
+INSERT INTO judging_rounds (round)
+VALUES ($1)
+ON CONFLICT (round) DO NOTHING;
+
+-- name: LockJudgingRoundState :one
+SELECT state FROM judging_rounds
+WHERE round = $1
+FOR SHARE;
+
+-- name: LockJudgingRoundForUpdate :one
+SELECT state FROM judging_rounds
+WHERE round = $1
+FOR UPDATE;
@@ -0,0 +1,31 @@
+-- name: CreateTeamNameFlag :exec
+INSERT INTO team_name_flags (
+    id, team_id, name, reason
+) VALUES (
+    $1, $2, $3, $4
+);
+
+-- name: GetTeamNameFlag :one
+SELECT * FROM team_name_flags
+WHERE id = $1;
+
+-- name: GetPendingTeamNameFlags :many
+SELECT f.id, f.team_id, f.name, f.reason, f.created_at, t.name AS current_name
+FROM team_name_flags f
+JOIN teams t ON t.id = f.team_id
+WHERE f.status = 'pending'
+ORDER BY f.created_at;
+
+-- name: ResolveTeamNameFlag :exec
+UPDATE team_name_flags
+SET status = $2,
+    reviewed_by = $3,
+    reviewed_at = CURRENT_TIMESTAMP
+WHERE id = $1;
+
+-- name: ResolveTeamNameFlagsByTeam :exec
+UPDATE team_name_flags
+SET status = $2,
+    reviewed_by = $3,
+    reviewed_at = CURRENT_TIMESTAMP
+WHERE team_id = $1 AND status = 'pending';
@@ -0,0 +1,62 @@
+-- name: UpsertPhase :one
+INSERT INTO phases (
+    name, opens_at, closes_at, grace_minutes
+) VALUES (
+    $1, $2, $3, $4
+)
+ON CONFLICT (name) DO UPDATE
+SET opens_at = EXCLUDED.opens_at,
+    closes_at = EXCLUDED.closes_at,
+    grace_minutes = EXCLUDED.grace_minutes
+RETURNING *;
+
+-- name: GetPhases :many
+SELECT * FROM phases
+ORDER BY opens_at;
+
+-- name: GetPhase :one
+SELECT * FROM phases
+WHERE name = $1;
+
+-- name: UpsertPhaseOverride :exec
+INSERT INTO phase_overrides (
+    id, phase, team_id, closes_at, granted_by
+) VALUES (
+    $1, $2, $3, $4, $5
+)
+ON CONFLICT (phase, team_id) DO UPDATE
+SET closes_at = EXCLUDED.closes_at,
+    granted_by = EXCLUDED.granted_by,
+    created_at = CURRENT_TIMESTAMP;
+
+-- name: DeletePhaseOverride :exec
+DELETE FROM phase_overrides
+WHERE phase = $1 AND team_id = $2;
+
+-- name: GetPhaseOverrides :many
+SELECT * FROM phase_overrides
+WHERE phase = $1
+ORDER BY created_at;
+
+-- name: IsPhaseOpenForTeam :one
+SELECT NOT EXISTS (
+    SELECT 1 FROM phases p
+    WHERE p.name = @phase
+) OR EXISTS (
+    SELECT 1 FROM phases p
+    WHERE p.name = @phase
+      AND NOW() BETWEEN p.opens_at AND p.closes_at
+) OR EXISTS (
+    SELECT 1 FROM phase_overrides o
+    WHERE o.phase = @phase
+      AND o.team_id = @team_id
+      AND NOW() < o.closes_at
+) AS is_open;
+
+-- name: GetPhaseWindow :one
+SELECT p.opens_at,
+    GREATEST(p.closes_at, COALESCE(o.closes_at, p.closes_at))::TIMESTAMPTZ AS closes_at,
+    p.grace_minutes
+FROM phases p
+LEFT JOIN phase_overrides o ON o.phase = p.name AND o.team_id = @team_id
+WHERE p.name = @phase;
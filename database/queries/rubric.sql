@@ -0,0 +1,43 @@
+-- name: CreateRubricCriterion :one
+INSERT INTO rubric_criteria (
+    id, round, slug, name, description, weight, max_points, position
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8
+)
+RETURNING *;
+
+-- name: GetRubricCriteria :many
+SELECT * FROM rubric_criteria
+WHERE round = $1
+ORDER BY position, slug;
+
+-- name: ListRubricCriteria :many
+SELECT * FROM rubric_criteria
+ORDER BY round, position, slug;
+
+-- name: UpdateRubricCriterion :one
+UPDATE rubric_criteria
+SET name = $2,
+    description = $3,
+    weight = $4,
+    max_points = $5,
+    position = $6
+WHERE id = $1
+RETURNING *;
+
+-- name: DeleteRubricCriterion :exec
+DELETE FROM rubric_criteria
+WHERE id = $1;
+
+-- name: GetRubricCriterion :one
+SELECT * FROM rubric_criteria
+WHERE id = $1;
+
+-- name: GetCriterionMaxStoredPoints :one
+SELECT COALESCE(MAX(points), 0)::INTEGER AS max_points
+FROM score_criteria
+WHERE criterion_id = $1;
+
+-- name: CountRoundScores :one
+SELECT COUNT(*) FROM score
+WHERE round = $1;
@@ -1,60 +1,177 @@
 -- name: GetTeamScores :many
-SELECT id, team_id, design, implementation, presentation, round, innovation, teamwork, comment
-FROM score
-WHERE team_id = $1;
+SELECT s.id, s.team_id, s.round, s.comment, s.panel_id, u.email AS judge,
+    COALESCE(SUM(sc.points * c.weight), 0)::FLOAT8 AS total
+FROM score s
+LEFT JOIN users u ON u.id = s.panel_id
+LEFT JOIN score_criteria sc ON sc.score_id = s.id
+LEFT JOIN rubric_criteria c ON c.id = sc.criterion_id
+WHERE s.team_id = $1
+GROUP BY s.id, u.email
+ORDER BY s.round, u.email;
+
+-- name: GetScoreByID :one
+SELECT * FROM score
+WHERE id = $1;
+
+-- name: GetTeamScoreCriteria :many
+SELECT sc.score_id, c.slug, sc.points
+FROM score_criteria sc
+JOIN score s ON s.id = sc.score_id
+JOIN rubric_criteria c ON c.id = sc.criterion_id
+WHERE s.team_id = $1
+ORDER BY c.position, c.slug;
 
 -- name: CreateScore :exec
-INSERT INTO score (id, team_id, design, implementation, presentation, round,  innovation, teamwork, comment)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9);
+INSERT INTO score (id, team_id, round, comment, panel_id)
+VALUES ($1, $2, $3, $4, $5);
 
 -- name: UpdateScore :exec
 UPDATE score
-SET team_id = $1, design = $2, implementation = $3, presentation = $4, round = $5, innovation = $6, teamwork = $7, comment = $8
-WHERE id = $9;
+SET team_id = $1, round = $2, comment = $3
+WHERE id = $4;
 
 -- name: DeleteScore :exec
 DELETE FROM score
 WHERE id = $1;
 
--- name: GetLeaderboardWithPagination :many
+-- name: DeleteTeamScores :exec
+DELETE FROM score
+WHERE team_id = $1;
+
+-- name: TransferScores :exec
+UPDATE score
+SET team_id = @target_team_id
+WHERE team_id = @source_team_id;
+
+-- name: CreateScoreCriterion :exec
+INSERT INTO score_criteria (score_id, criterion_id, points)
+VALUES ($1, $2, $3);
+
+-- name: DeleteScoreCriteria :exec
+DELETE FROM score_criteria
+WHERE score_id = $1;
+
+-- name: GetLeaderboard :many
 WITH RoundScores AS (
-    SELECT 
-        s.team_id,
-        t.name,
-        s.round,
-        s.design,
-        s.implementation,
-        s.presentation,
-        s.innovation,
-        s.teamwork,
-        (s.design + s.implementation + s.presentation + s.innovation + s.teamwork) AS round_total
+    SELECT
+        team_id,
+        round,
+        (CASE WHEN @aggregate::TEXT = 'median'
+            THEN PERCENTILE_CONT(0.5) WITHIN GROUP (ORDER BY total)
+            ELSE AVG(total)
+        END)::FLOAT8 AS round_total,
+        (CASE WHEN @aggregate::TEXT = 'median'
+            THEN PERCENTILE_CONT(0.5) WITHIN GROUP (ORDER BY normalised)
+            ELSE AVG(normalised)
+        END)::FLOAT8 AS normalised_total
+    FROM normalised_scores
+    WHERE @round::INTEGER = 0 OR round = @round::INTEGER
+    GROUP BY team_id, round
+),
+Innovation AS (
+    SELECT s.team_id, AVG(sc.points)::FLOAT8 AS innovation
     FROM score s
-    JOIN teams t ON s.team_id = t.id
+    JOIN score_criteria sc ON sc.score_id = s.id
+    JOIN rubric_criteria c ON c.id = sc.criterion_id
+    WHERE c.slug = 'innovation'
+      AND (@round::INTEGER = 0 OR s.round = @round::INTEGER)
+    GROUP BY s.team_id
+),
+TeamTracks AS (
+    SELECT t.id AS team_id,
+        COALESCE(
+            (SELECT s.track FROM submission s WHERE s.team_id = t.id AND s.track <> '' ORDER BY s.round DESC LIMIT 1),
+            (SELECT i.track FROM ideas i WHERE i.team_id = t.id LIMIT 1),
+            ''
+        )::TEXT AS track
+    FROM teams t
+),
+TeamScores AS (
+    SELECT
+        team_id,
+        SUM(round_total)::FLOAT8 AS overall_total,
+        SUM(normalised_total)::FLOAT8 AS overall_normalised,
+        COALESCE(SUM(normalised_total) FILTER (WHERE round = (SELECT MAX(round) FROM RoundScores)), 0)::FLOAT8 AS latest_round_total
+    FROM RoundScores
+    GROUP BY team_id
 ),
-TotalScores AS (
-    SELECT 
-        s.team_id,
+Ranked AS (
+    SELECT
+        ts.team_id,
         t.name,
-        SUM(s.design + s.implementation + s.presentation + s.innovation + s.teamwork) AS overall_total
-    FROM score s
-    JOIN teams t ON s.team_id = t.id
-    GROUP BY s.team_id, t.name
+        tt.track,
+        ts.overall_total,
+        ts.overall_normalised,
+        ts.latest_round_total,
+        COALESCE(i.innovation, 0)::FLOAT8 AS innovation,
+        RANK() OVER w AS competition_rank,
+        DENSE_RANK() OVER w AS dense_rank
+    FROM TeamScores ts
+    JOIN teams t ON t.id = ts.team_id
+    JOIN TeamTracks tt ON tt.team_id = ts.team_id
+    LEFT JOIN Innovation i ON i.team_id = ts.team_id
+    WHERE (@track::TEXT = '' OR tt.track = @track::TEXT)
+      AND (@qualified::INTEGER = 0 OR GREATEST(COALESCE(t.round_qualified, 0), 1) >= @qualified::INTEGER)
+    WINDOW w AS (ORDER BY ts.overall_normalised DESC, ts.latest_round_total DESC, COALESCE(i.innovation, 0) DESC)
 )
-SELECT 
-    rs.team_id,
-    rs.name,
-    rs.round,
-    rs.design,
-    rs.implementation,
-    rs.presentation,
-    rs.innovation,
-    rs.teamwork,
-    rs.round_total,
-    ts.overall_total
-FROM RoundScores rs
-JOIN TotalScores ts ON rs.team_id = ts.team_id
-WHERE 
-    ($1::UUID IS NULL OR rs.team_id > $1)  -- Cursor-based pagination
-    AND ($2::TEXT IS NULL OR rs.name ILIKE '%' || $2 || '%') -- Optional name filter
-ORDER BY ts.overall_total DESC, rs.round ASC
-LIMIT $3;
\ No newline at end of file
+SELECT
+    team_id,
+    name,
+    track,
+    overall_total,
+    overall_normalised,
+    latest_round_total,
+    innovation,
+    competition_rank::INTEGER AS competition_rank,
+    dense_rank::INTEGER AS dense_rank
+FROM Ranked
+WHERE (@name::TEXT = '' OR name ILIKE '%' || @name || '%')
+    AND (NOT @has_cursor::BOOLEAN
+        OR (overall_normalised, latest_round_total, innovation, team_id)
+            < (@cursor_total::FLOAT8, @cursor_latest::FLOAT8, @cursor_innovation::FLOAT8, @cursor_team::UUID))
+ORDER BY overall_normalised DESC, latest_round_total DESC, innovation DESC, team_id DESC
+LIMIT @row_limit;
+
+-- name: GetLeaderboardRounds :many
+SELECT
+    n.team_id,
+    n.round,
+    MIN(n.normalisation)::TEXT AS normalisation,
+    (CASE WHEN @aggregate::TEXT = 'median'
+        THEN PERCENTILE_CONT(0.5) WITHIN GROUP (ORDER BY n.total)
+        ELSE AVG(n.total)
+    END)::FLOAT8 AS round_total,
+    (CASE WHEN @aggregate::TEXT = 'median'
+        THEN PERCENTILE_CONT(0.5) WITHIN GROUP (ORDER BY n.normalised)
+        ELSE AVG(n.normalised)
+    END)::FLOAT8 AS normalised_total,
+    COUNT(*)::INTEGER AS judges,
+    COALESCE(jr.state, 'open')::TEXT AS state,
+    jr.finalised_at
+FROM normalised_scores n
+LEFT JOIN judging_rounds jr ON jr.round = n.round
+WHERE n.team_id = ANY(@team_ids::UUID[])
+  AND (@round::INTEGER = 0 OR n.round = @round::INTEGER)
+GROUP BY n.team_id, n.round, jr.state, jr.finalised_at
+ORDER BY n.team_id, n.round;
+
+-- name: GetLeaderboardCriteria :many
+SELECT s.team_id, s.round, c.slug,
+    (CASE WHEN @aggregate::TEXT = 'median'
+        THEN PERCENTILE_CONT(0.5) WITHIN GROUP (ORDER BY sc.points)
+        ELSE AVG(sc.points)
+    END)::FLOAT8 AS points
+FROM score s
+JOIN score_criteria sc ON sc.score_id = s.id
+JOIN rubric_criteria c ON c.id = sc.criterion_id
+WHERE s.team_id = ANY(@team_ids::UUID[])
+  AND (@round::INTEGER = 0 OR s.round = @round::INTEGER)
+GROUP BY s.team_id, s.round, c.slug, c.position
+ORDER BY s.team_id, s.round, c.position;
+
+-- name: GetScoreExport :many
+SELECT n.id, n.team_id, t.name, n.round, u.email AS judge, n.total, n.normalisation, n.normalised
+FROM normalised_scores n
+JOIN teams t ON t.id = n.team_id
+LEFT JOIN users u ON u.id = n.panel_id
+ORDER BY n.round, t.name, u.email;
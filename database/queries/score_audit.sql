@@ -0,0 +1,28 @@
+-- name: GetScoreSnapshot :one
+SELECT score_snapshot(s.id)::JSONB AS snapshot
+FROM score s
+WHERE s.id = $1;
+
+-- name: SetScoreAuditContext :exec
+SELECT set_config('devsoc.actor_id', @actor_id::TEXT, true),
+    set_config('devsoc.audit_reason', @reason::TEXT, true);
+
+-- name: CreateScoreAudit :exec
+INSERT INTO score_audit (
+    id, score_id, team_id, round, panel_id, actor_id, action, before, after, reason
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10
+);
+
+-- name: GetScoreAudit :many
+SELECT a.id, a.score_id, a.team_id, t.name AS team_name, a.round,
+    a.panel_id, p.email AS judge, a.actor_id, u.email AS actor,
+    a.action, a.before, a.after, a.reason, a.created_at
+FROM score_audit a
+LEFT JOIN teams t ON t.id = a.team_id
+LEFT JOIN users p ON p.id = a.panel_id
+LEFT JOIN users u ON u.id = a.actor_id
+WHERE (sqlc.narg(team_id)::UUID IS NULL OR a.team_id = sqlc.narg(team_id))
+  AND (sqlc.narg(panel_id)::UUID IS NULL OR a.panel_id = sqlc.narg(panel_id))
+ORDER BY a.created_at DESC
+LIMIT @row_limit;
@@ -1,5 +1,17 @@
 -- name: GetSubmissionByTeamID :one
-SELECT * FROM submission WHERE team_id = $1;
+SELECT * FROM submission
+WHERE team_id = $1
+ORDER BY round DESC
+LIMIT 1;
+
+-- name: GetSubmissionByRound :one
+SELECT * FROM submission
+WHERE team_id = $1 AND round = $2;
+
+-- name: GetTeamSubmissions :many
+SELECT * FROM submission
+WHERE team_id = $1
+ORDER BY round;
 
 -- name: CreateSubmission :one
 INSERT INTO submission (
@@ -10,8 +22,10 @@ INSERT INTO submission (
     track,
     github_link,
     figma_link,
-    other_link
-) VALUES ($1, $2, $3, $4, $5, $6, $7, $8) RETURNING *;
+    other_link,
+    is_late,
+    round
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10) RETURNING *;
 
 -- name: UpdateSubmission :one
 UPDATE submission
@@ -20,9 +34,41 @@ SET github_link = $2,
     other_link = $4,
     title = $5,
     description = $6,
-    track = $7
-WHERE team_id = $1
+    track = $7,
+    is_late = $8,
+    updated_at = CURRENT_TIMESTAMP
+WHERE team_id = $1 AND round = $9
 RETURNING *;
 
 -- name: DeleteSubmission :exec
+DELETE FROM submission WHERE team_id = $1 AND round = $2;
+
+-- name: DeleteTeamSubmissions :exec
 DELETE FROM submission WHERE team_id = $1;
+
+-- name: TransferSubmission :exec
+UPDATE submission
+SET team_id = @target_team_id
+WHERE team_id = @source_team_id;
+
+-- name: GetSubmittedRepos :many
+SELECT DISTINCT ON (t.name, s.team_id) s.team_id, t.name, s.github_link
+FROM submission s
+JOIN teams t ON t.id = s.team_id
+ORDER BY t.name, s.team_id, s.round DESC;
+
+-- name: GetSubmissionRequirements :one
+SELECT required_fields FROM submission_requirements
+WHERE round = $1;
+
+-- name: ListSubmissionRequirements :many
+SELECT * FROM submission_requirements
+ORDER BY round;
+
+-- name: UpsertSubmissionRequirements :one
+INSERT INTO submission_requirements (round, required_fields)
+VALUES ($1, $2)
+ON CONFLICT (round) DO UPDATE
+SET required_fields = EXCLUDED.required_fields,
+    updated_at = CURRENT_TIMESTAMP
+RETURNING *;
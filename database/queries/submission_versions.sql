@@ -0,0 +1,48 @@
+-- name: CreateSubmissionVersion :exec
+INSERT INTO submission_versions (
+    id, team_id, round, version, title, description, track, github_link, figma_link, other_link, is_late, author_id
+)
+SELECT $1, $2, $3, COALESCE(MAX(version), 0) + 1, $4, $5, $6, $7, $8, $9, $10, $11
+FROM submission_versions
+WHERE team_id = $2 AND round = $3;
+
+-- name: GetSubmissionVersions :many
+SELECT v.version, v.title, v.description, v.track, v.github_link, v.figma_link, v.other_link, v.is_late, v.created_at,
+    u.first_name, u.last_name
+FROM submission_versions v
+LEFT JOIN users u ON u.id = v.author_id
+WHERE v.team_id = $1 AND v.round = $2
+ORDER BY v.version DESC;
+
+-- name: FreezeSubmissions :execrows
+INSERT INTO submission_snapshots (
+    id, team_id, round, version, title, description, track, github_link, figma_link, other_link, is_late, frozen_by
+)
+SELECT gen_random_uuid(), s.team_id, @round::INTEGER,
+    COALESCE((SELECT MAX(v.version) FROM submission_versions v WHERE v.team_id = s.team_id AND v.round = s.round), 0),
+    s.title, s.description, s.track, s.github_link, s.figma_link, s.other_link, s.is_late, @frozen_by
+FROM submission s
+WHERE s.round = @round::INTEGER
+ON CONFLICT (team_id, round) DO NOTHING;
+
+-- name: GetSubmissionSnapshot :one
+SELECT * FROM submission_snapshots
+WHERE team_id = $1 AND round = $2;
+
+-- name: DeleteTeamSubmissionVersions :exec
+DELETE FROM submission_versions
+WHERE team_id = $1;
+
+-- name: TransferSubmissionVersions :exec
+UPDATE submission_versions
+SET team_id = @target_team_id
+WHERE team_id = @source_team_id;
+
+-- name: DeleteTeamSnapshots :exec
+DELETE FROM submission_snapshots
+WHERE team_id = $1;
+
+-- name: TransferSnapshots :exec
+UPDATE submission_snapshots
+SET team_id = @target_team_id
+WHERE team_id = @source_team_id;
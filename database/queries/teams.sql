@@ -18,12 +18,11 @@ WHERE i.track = $1;
 
 -- name: GetTeamById :one
 SELECT teams.id, teams.name, teams.round_qualified, teams.code,teams.is_banned,
-       score.design, score.implementation, score.presentation, score.round,
        submission.title, submission.description, submission.track, submission.github_link, submission.figma_link, submission.other_link,
        ideas.title, ideas.description, ideas.track, ideas.is_selected
 FROM teams
-INNER JOIN score ON score.team_id = teams.id
 LEFT JOIN submission ON submission.team_id = teams.id
+    AND submission.round = GREATEST(COALESCE(teams.round_qualified, 0), 1)
 LEFT JOIN ideas ON ideas.team_id = teams.id
 WHERE teams.id = $1;
 
@@ -134,5 +133,71 @@ UPDATE teams
 SET round_qualified = $1
 WHERE id = $2;
 
+-- name: GetQualificationPool :many
+SELECT id, name, COALESCE(round_qualified, 0)::INTEGER AS round_qualified
+FROM teams
+WHERE NOT is_banned
+  AND GREATEST(COALESCE(round_qualified, 0), 1) >= @round::INTEGER
+ORDER BY name;
+
+-- name: GetTeamsUsersEmails :many
+SELECT team_id, email
+FROM users
+WHERE team_id = ANY(@team_ids::UUID[]);
+
 -- name: InfoQuery :many
 SELECT * FROM teams INNER JOIN users ON users.team_id = teams.id WHERE teams.id = $1;
+
+-- name: SyncTeamMemberCount :exec
+UPDATE teams
+SET number_of_people = (SELECT COUNT(*) FROM users WHERE users.team_id = teams.id)
+WHERE teams.id = $1;
+
+-- name: ClearTeamMembers :exec
+UPDATE users
+SET team_id = NULL, is_leader = FALSE
+WHERE team_id = $1;
+
+-- name: MoveTeamMembers :exec
+UPDATE users
+SET team_id = @target_team_id, is_leader = FALSE
+WHERE team_id = @source_team_id;
+
+-- name: PromoteNextLeader :exec
+UPDATE users
+SET is_leader = TRUE
+WHERE id = (
+    SELECT id FROM users
+    WHERE team_id = $1
+    ORDER BY id
+    LIMIT 1
+);
+
+-- name: UpdateTeamProfile :one
+UPDATE teams
+SET tagline = $2,
+    description = $3,
+    repo_link = $4,
+    demo_link = $5
+WHERE id = $1
+RETURNING *;
+
+-- name: UpdateTeamAvatar :exec
+UPDATE teams
+SET avatar = $1
+WHERE id = $2;
+
+-- name: GetProjectGallery :many
+SELECT t.id, t.name, t.tagline, t.description, t.avatar, t.repo_link, t.demo_link, i.title, i.track
+FROM teams t
+LEFT JOIN ideas i ON i.team_id = t.id
+WHERE t.is_banned = FALSE
+  AND (t.tagline <> '' OR t.description <> '')
+  AND t.id > $1
+ORDER BY t.id
+LIMIT $2;
+
+-- name: LockTeam :exec
+SELECT id FROM teams
+WHERE id = $1
+FOR UPDATE;
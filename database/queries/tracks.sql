@@ -0,0 +1,43 @@
+-- name: CreateTrack :one
+INSERT INTO tracks (
+    slug, name, description, capacity, sponsor, selection_quota
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+)
+RETURNING *;
+
+-- name: GetTracks :many
+SELECT * FROM tracks
+ORDER BY name;
+
+-- name: GetTrack :one
+SELECT * FROM tracks
+WHERE slug = $1;
+
+-- name: UpdateTrack :one
+UPDATE tracks
+SET name = $2,
+    description = $3,
+    capacity = $4,
+    sponsor = $5,
+    selection_quota = $6,
+    updated_at = CURRENT_TIMESTAMP
+WHERE slug = $1
+RETURNING *;
+
+-- name: DeleteTrack :exec
+DELETE FROM tracks
+WHERE slug = $1;
+
+-- name: CountIdeasByTrack :one
+SELECT COUNT(*) FROM ideas
+WHERE track = $1;
+
+-- name: GetOverQuotaTracks :many
+SELECT t.slug, t.selection_quota, COUNT(i.id) AS selected
+FROM tracks t
+JOIN ideas i ON i.track = t.slug AND i.status = 'selected'
+WHERE t.selection_quota > 0
+  AND t.slug = ANY(@slugs::TEXT[])
+GROUP BY t.slug, t.selection_quota
+HAVING COUNT(i.id) > t.selection_quota;
@@ -114,3 +114,8 @@ UPDATE users
 SET
     github_profile = $1
 WHERE email = $2;
+
+-- name: GetPanelUsers :many
+SELECT * FROM users
+WHERE role = 'panel' AND is_banned = FALSE
+ORDER BY email;
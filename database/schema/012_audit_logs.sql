@@ -0,0 +1,14 @@
+-- +goose Up
+CREATE TABLE audit_logs (
+    id UUID NOT NULL UNIQUE,
+    actor_id UUID NOT NULL,
+    action TEXT NOT NULL,
+    team_id UUID,
+    target_id UUID,
+    details TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (id)
+);
+
+-- +goose Down
+DROP TABLE audit_logs;
@@ -0,0 +1,15 @@
+-- +goose Up
+ALTER TABLE teams
+ADD COLUMN tagline TEXT NOT NULL DEFAULT '',
+ADD COLUMN description TEXT NOT NULL DEFAULT '',
+ADD COLUMN avatar TEXT NOT NULL DEFAULT '',
+ADD COLUMN repo_link TEXT NOT NULL DEFAULT '',
+ADD COLUMN demo_link TEXT NOT NULL DEFAULT '';
+
+-- +goose Down
+ALTER TABLE teams
+DROP COLUMN tagline,
+DROP COLUMN description,
+DROP COLUMN avatar,
+DROP COLUMN repo_link,
+DROP COLUMN demo_link;
@@ -0,0 +1,17 @@
+-- +goose Up
+CREATE TABLE team_name_flags (
+    id UUID NOT NULL UNIQUE,
+    team_id UUID NOT NULL,
+    name TEXT NOT NULL,
+    reason TEXT NOT NULL,
+    status TEXT NOT NULL DEFAULT 'pending', -- pending/approved/renamed
+    reviewed_by UUID,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    reviewed_at TIMESTAMP,
+    PRIMARY KEY (id)
+);
+
+ALTER TABLE team_name_flags ADD CONSTRAINT fk_team_name_flags_teams FOREIGN KEY(team_id) REFERENCES teams(id) ON UPDATE CASCADE ON DELETE CASCADE;
+
+-- +goose Down
+DROP TABLE team_name_flags;
@@ -0,0 +1,27 @@
+-- +goose Up
+CREATE TABLE phases (
+    name TEXT NOT NULL UNIQUE,
+    opens_at TIMESTAMPTZ NOT NULL,
+    closes_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (name)
+);
+
+CREATE TABLE phase_overrides (
+    id UUID NOT NULL UNIQUE,
+    phase TEXT NOT NULL,
+    team_id UUID NOT NULL,
+    closes_at TIMESTAMPTZ NOT NULL,
+    granted_by UUID NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (id),
+    UNIQUE (phase, team_id)
+);
+
+ALTER TABLE phase_overrides ADD CONSTRAINT fk_phase_overrides_phases FOREIGN KEY(phase) REFERENCES phases(name) ON UPDATE CASCADE ON DELETE CASCADE;
+
+ALTER TABLE phase_overrides ADD CONSTRAINT fk_phase_overrides_teams FOREIGN KEY(team_id) REFERENCES teams(id) ON UPDATE CASCADE ON DELETE CASCADE;
+
+-- +goose Down
+DROP TABLE phase_overrides;
+
+DROP TABLE phases;
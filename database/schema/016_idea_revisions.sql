@@ -0,0 +1,26 @@
+-- +goose Up
+CREATE TABLE idea_revisions (
+    id UUID NOT NULL UNIQUE,
+    idea_id UUID NOT NULL,
+    revision INTEGER NOT NULL,
+    title TEXT NOT NULL,
+    description TEXT NOT NULL,
+    track TEXT NOT NULL,
+    author_id UUID,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (id),
+    UNIQUE (idea_id, revision)
+);
+
+ALTER TABLE idea_revisions ADD CONSTRAINT fk_idea_revisions_ideas FOREIGN KEY(idea_id) REFERENCES ideas(id) ON UPDATE CASCADE ON DELETE CASCADE;
+
+ALTER TABLE idea_revisions ADD CONSTRAINT fk_idea_revisions_users FOREIGN KEY(author_id) REFERENCES users(id) ON UPDATE CASCADE ON DELETE SET NULL;
+
+INSERT INTO idea_revisions (id, idea_id, revision, title, description, track, author_id, created_at)
+SELECT gen_random_uuid(), i.id, 1, i.title, i.description, i.track,
+    (SELECT u.id FROM users u WHERE u.team_id = i.team_id AND u.is_leader LIMIT 1),
+    i.updated_at
+FROM ideas i;
+
+-- +goose Down
+DROP TABLE idea_revisions;
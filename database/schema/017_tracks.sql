@@ -0,0 +1,38 @@
+-- +goose Up
+CREATE TABLE tracks (
+    slug TEXT NOT NULL UNIQUE,
+    name TEXT NOT NULL UNIQUE,
+    description TEXT NOT NULL DEFAULT '',
+    capacity INTEGER NOT NULL DEFAULT 0,
+    sponsor TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (slug)
+);
+
+-- Existing free-text tracks are folded into slugs ("AI/ML" and "ai-ml" both
+-- become "ai-ml"); values with nothing usable left map to "general".
+UPDATE ideas
+SET track = COALESCE(NULLIF(TRIM(BOTH '-' FROM REGEXP_REPLACE(LOWER(track), '[^a-z0-9]+', '-', 'g')), ''), 'general');
+
+UPDATE submission
+SET track = COALESCE(NULLIF(TRIM(BOTH '-' FROM REGEXP_REPLACE(LOWER(track), '[^a-z0-9]+', '-', 'g')), ''), 'general');
+
+INSERT INTO tracks (slug, name)
+SELECT slug, INITCAP(REPLACE(slug, '-', ' '))
+FROM (
+    SELECT track AS slug FROM ideas
+    UNION
+    SELECT track AS slug FROM submission
+) existing;
+
+ALTER TABLE ideas ADD CONSTRAINT fk_ideas_tracks FOREIGN KEY(track) REFERENCES tracks(slug) ON UPDATE CASCADE ON DELETE RESTRICT;
+
+ALTER TABLE submission ADD CONSTRAINT fk_submission_tracks FOREIGN KEY(track) REFERENCES tracks(slug) ON UPDATE CASCADE ON DELETE RESTRICT;
+
+-- +goose Down
+ALTER TABLE submission DROP CONSTRAINT fk_submission_tracks;
+
+ALTER TABLE ideas DROP CONSTRAINT fk_ideas_tracks;
+
+DROP TABLE tracks;
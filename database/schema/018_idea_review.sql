@@ -0,0 +1,20 @@
+-- +goose Up
+ALTER TABLE ideas ADD COLUMN status TEXT NOT NULL DEFAULT 'pending';
+ALTER TABLE ideas ADD COLUMN review_note TEXT NOT NULL DEFAULT '';
+ALTER TABLE ideas ADD COLUMN reviewed_by UUID DEFAULT NULL;
+ALTER TABLE ideas ADD COLUMN reviewed_at TIMESTAMP DEFAULT NULL;
+
+ALTER TABLE ideas ADD CONSTRAINT chk_ideas_status CHECK (status IN ('pending', 'shortlisted', 'selected', 'rejected'));
+
+UPDATE ideas SET status = 'selected' WHERE is_selected;
+
+ALTER TABLE tracks ADD COLUMN selection_quota INTEGER NOT NULL DEFAULT 0;
+
+-- +goose Down
+ALTER TABLE tracks DROP COLUMN selection_quota;
+
+ALTER TABLE ideas DROP CONSTRAINT chk_ideas_status;
+ALTER TABLE ideas DROP COLUMN reviewed_at;
+ALTER TABLE ideas DROP COLUMN reviewed_by;
+ALTER TABLE ideas DROP COLUMN review_note;
+ALTER TABLE ideas DROP COLUMN status;
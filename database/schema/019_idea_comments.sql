@@ -0,0 +1,19 @@
+-- +goose Up
+CREATE TABLE idea_comments (
+    id UUID NOT NULL UNIQUE,
+    idea_id UUID NOT NULL,
+    author_id UUID,
+    body TEXT NOT NULL,
+    internal BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (id)
+);
+
+ALTER TABLE idea_comments ADD CONSTRAINT fk_idea_comments_ideas FOREIGN KEY(idea_id) REFERENCES ideas(id) ON UPDATE CASCADE ON DELETE CASCADE;
+
+ALTER TABLE idea_comments ADD CONSTRAINT fk_idea_comments_users FOREIGN KEY(author_id) REFERENCES users(id) ON UPDATE CASCADE ON DELETE SET NULL;
+
+CREATE INDEX idx_idea_comments_idea ON idea_comments (idea_id, created_at);
+
+-- +goose Down
+DROP TABLE idea_comments;
@@ -0,0 +1,11 @@
+-- +goose Up
+ALTER TABLE phases ADD COLUMN grace_minutes INTEGER NOT NULL DEFAULT 0;
+
+ALTER TABLE submission ADD COLUMN is_late BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE submission ADD COLUMN updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP;
+
+-- +goose Down
+ALTER TABLE submission DROP COLUMN updated_at;
+ALTER TABLE submission DROP COLUMN is_late;
+
+ALTER TABLE phases DROP COLUMN grace_minutes;
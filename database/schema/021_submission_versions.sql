@@ -0,0 +1,50 @@
+-- +goose Up
+CREATE TABLE submission_versions (
+    id UUID NOT NULL UNIQUE,
+    team_id UUID NOT NULL,
+    version INTEGER NOT NULL,
+    title TEXT NOT NULL,
+    description TEXT NOT NULL,
+    track TEXT NOT NULL,
+    github_link TEXT NOT NULL,
+    figma_link TEXT NOT NULL,
+    other_link TEXT NOT NULL,
+    is_late BOOLEAN NOT NULL DEFAULT FALSE,
+    author_id UUID,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (id),
+    UNIQUE (team_id, version)
+);
+
+CREATE TABLE submission_snapshots (
+    id UUID NOT NULL UNIQUE,
+    team_id UUID NOT NULL,
+    round INTEGER NOT NULL,
+    version INTEGER NOT NULL,
+    title TEXT NOT NULL,
+    description TEXT NOT NULL,
+    track TEXT NOT NULL,
+    github_link TEXT NOT NULL,
+    figma_link TEXT NOT NULL,
+    other_link TEXT NOT NULL,
+    is_late BOOLEAN NOT NULL,
+    frozen_by UUID NOT NULL,
+    frozen_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (id),
+    UNIQUE (team_id, round)
+);
+
+ALTER TABLE submission_versions ADD CONSTRAINT fk_submission_versions_teams FOREIGN KEY(team_id) REFERENCES teams(id) ON UPDATE CASCADE ON DELETE CASCADE;
+
+ALTER TABLE submission_versions ADD CONSTRAINT fk_submission_versions_users FOREIGN KEY(author_id) REFERENCES users(id) ON UPDATE CASCADE ON DELETE SET NULL;
+
+ALTER TABLE submission_snapshots ADD CONSTRAINT fk_submission_snapshots_teams FOREIGN KEY(team_id) REFERENCES teams(id) ON UPDATE CASCADE ON DELETE CASCADE;
+
+INSERT INTO submission_versions (id, team_id, version, title, description, track, github_link, figma_link, other_link, is_late, created_at)
+SELECT gen_random_uuid(), team_id, 1, title, description, track, github_link, figma_link, other_link, is_late, updated_at
+FROM submission;
+
+-- +goose Down
+DROP TABLE submission_snapshots;
+
+DROP TABLE submission_versions;
@@ -0,0 +1,19 @@
+-- +goose Up
+CREATE TABLE submission_attachments (
+    id UUID NOT NULL UNIQUE,
+    team_id UUID NOT NULL,
+    file_name TEXT NOT NULL,
+    content_type TEXT NOT NULL,
+    size BIGINT NOT NULL,
+    storage_key TEXT NOT NULL,
+    uploaded_by UUID,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (id)
+);
+
+ALTER TABLE submission_attachments ADD CONSTRAINT fk_submission_attachments_teams FOREIGN KEY(team_id) REFERENCES teams(id) ON UPDATE CASCADE ON DELETE CASCADE;
+
+ALTER TABLE submission_attachments ADD CONSTRAINT fk_submission_attachments_users FOREIGN KEY(uploaded_by) REFERENCES users(id) ON UPDATE CASCADE ON DELETE SET NULL;
+
+-- +goose Down
+DROP TABLE submission_attachments;
@@ -0,0 +1,53 @@
+-- +goose Up
+ALTER TABLE submission ADD COLUMN round INTEGER NOT NULL DEFAULT 1;
+
+UPDATE submission s
+SET round = GREATEST(COALESCE(t.round_qualified, 0), 1)
+FROM teams t
+WHERE t.id = s.team_id;
+
+ALTER TABLE submission DROP CONSTRAINT submission_team_id_key;
+ALTER TABLE submission ADD CONSTRAINT unique_submission_team_round UNIQUE (team_id, round);
+
+ALTER TABLE submission_versions ADD COLUMN round INTEGER NOT NULL DEFAULT 1;
+
+UPDATE submission_versions v
+SET round = s.round
+FROM submission s
+WHERE s.team_id = v.team_id;
+
+ALTER TABLE submission_versions DROP CONSTRAINT submission_versions_team_id_version_key;
+ALTER TABLE submission_versions ADD CONSTRAINT unique_submission_versions_team_round_version UNIQUE (team_id, round, version);
+
+ALTER TABLE submission_attachments ADD COLUMN round INTEGER NOT NULL DEFAULT 1;
+
+UPDATE submission_attachments a
+SET round = s.round
+FROM submission s
+WHERE s.team_id = a.team_id;
+
+CREATE TABLE submission_requirements (
+    round INTEGER NOT NULL,
+    required_fields TEXT[] NOT NULL,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (round)
+);
+
+-- +goose Down
+DROP TABLE submission_requirements;
+
+ALTER TABLE submission_attachments DROP COLUMN round;
+
+ALTER TABLE submission_versions DROP CONSTRAINT unique_submission_versions_team_round_version;
+DELETE FROM submission_versions v
+USING submission_versions newer
+WHERE newer.team_id = v.team_id AND newer.round > v.round;
+ALTER TABLE submission_versions DROP COLUMN round;
+ALTER TABLE submission_versions ADD CONSTRAINT submission_versions_team_id_version_key UNIQUE (team_id, version);
+
+ALTER TABLE submission DROP CONSTRAINT unique_submission_team_round;
+DELETE FROM submission s
+USING submission newer
+WHERE newer.team_id = s.team_id AND newer.round > s.round;
+ALTER TABLE submission DROP COLUMN round;
+ALTER TABLE submission ADD CONSTRAINT submission_team_id_key UNIQUE (team_id);
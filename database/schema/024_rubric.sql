@@ -0,0 +1,76 @@
+-- +goose Up
+CREATE TABLE rubric_criteria (
+    id UUID NOT NULL UNIQUE,
+    round INTEGER NOT NULL,
+    slug TEXT NOT NULL,
+    name TEXT NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    weight DOUBLE PRECISION NOT NULL DEFAULT 1 CHECK (weight > 0),
+    max_points INTEGER NOT NULL DEFAULT 10 CHECK (max_points > 0),
+    position INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (id),
+    UNIQUE (round, slug)
+);
+
+CREATE TABLE score_criteria (
+    score_id UUID NOT NULL,
+    criterion_id UUID NOT NULL,
+    points INTEGER NOT NULL CHECK (points >= 0),
+    PRIMARY KEY (score_id, criterion_id)
+);
+
+ALTER TABLE score_criteria ADD CONSTRAINT fk_score_criteria_score FOREIGN KEY(score_id) REFERENCES score(id) ON UPDATE CASCADE ON DELETE CASCADE;
+
+ALTER TABLE score_criteria ADD CONSTRAINT fk_score_criteria_rubric_criteria FOREIGN KEY(criterion_id) REFERENCES rubric_criteria(id) ON UPDATE CASCADE ON DELETE RESTRICT;
+
+-- Every round that already has scores gets the five criteria that used to be
+-- hard-coded columns, each worth up to 10 points with equal weight.
+INSERT INTO rubric_criteria (id, round, slug, name, weight, max_points, position)
+SELECT gen_random_uuid(), r.round, c.slug, c.name, 1, 10, c.position
+FROM (SELECT DISTINCT round FROM score) r
+CROSS JOIN (VALUES
+    ('design', 'Design', 1),
+    ('implementation', 'Implementation', 2),
+    ('presentation', 'Presentation', 3),
+    ('innovation', 'Innovation', 4),
+    ('teamwork', 'Teamwork', 5)
+) AS c(slug, name, position);
+
+INSERT INTO score_criteria (score_id, criterion_id, points)
+SELECT s.id, c.id,
+    CASE c.slug
+        WHEN 'design' THEN s.design
+        WHEN 'implementation' THEN s.implementation
+        WHEN 'presentation' THEN s.presentation
+        WHEN 'innovation' THEN s.innovation
+        WHEN 'teamwork' THEN s.teamwork
+    END
+FROM score s
+JOIN rubric_criteria c ON c.round = s.round;
+
+ALTER TABLE score
+DROP COLUMN design,
+DROP COLUMN implementation,
+DROP COLUMN presentation,
+DROP COLUMN innovation,
+DROP COLUMN teamwork;
+
+-- +goose Down
+ALTER TABLE score
+ADD COLUMN design INTEGER NOT NULL DEFAULT 0,
+ADD COLUMN implementation INTEGER NOT NULL DEFAULT 0,
+ADD COLUMN presentation INTEGER NOT NULL DEFAULT 0,
+ADD COLUMN innovation INTEGER NOT NULL DEFAULT 0,
+ADD COLUMN teamwork INTEGER NOT NULL DEFAULT 0;
+
+UPDATE score s
+SET design = COALESCE((SELECT sc.points FROM score_criteria sc JOIN rubric_criteria c ON c.id = sc.criterion_id WHERE sc.score_id = s.id AND c.slug = 'design'), 0),
+    implementation = COALESCE((SELECT sc.points FROM score_criteria sc JOIN rubric_criteria c ON c.id = sc.criterion_id WHERE sc.score_id = s.id AND c.slug = 'implementation'), 0),
+    presentation = COALESCE((SELECT sc.points FROM score_criteria sc JOIN rubric_criteria c ON c.id = sc.criterion_id WHERE sc.score_id = s.id AND c.slug = 'presentation'), 0),
+    innovation = COALESCE((SELECT sc.points FROM score_criteria sc JOIN rubric_criteria c ON c.id = sc.criterion_id WHERE sc.score_id = s.id AND c.slug = 'innovation'), 0),
+    teamwork = COALESCE((SELECT sc.points FROM score_criteria sc JOIN rubric_criteria c ON c.id = sc.criterion_id WHERE sc.score_id = s.id AND c.slug = 'teamwork'), 0);
+
+DROP TABLE score_criteria;
+
+DROP TABLE rubric_criteria;
@@ -0,0 +1,20 @@
+-- +goose Up
+CREATE TABLE panel_assignments (
+    id UUID NOT NULL UNIQUE,
+    round INTEGER NOT NULL,
+    panel_id UUID NOT NULL,
+    team_id UUID NOT NULL,
+    assigned_by UUID NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (id),
+    UNIQUE (round, panel_id, team_id)
+);
+
+ALTER TABLE panel_assignments ADD CONSTRAINT fk_panel_assignments_panel FOREIGN KEY(panel_id) REFERENCES users(id) ON UPDATE CASCADE ON DELETE CASCADE;
+
+ALTER TABLE panel_assignments ADD CONSTRAINT fk_panel_assignments_teams FOREIGN KEY(team_id) REFERENCES teams(id) ON UPDATE CASCADE ON DELETE CASCADE;
+
+CREATE INDEX idx_panel_assignments_team ON panel_assignments (team_id, round);
+
+-- +goose Down
+DROP TABLE panel_assignments;
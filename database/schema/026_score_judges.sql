@@ -0,0 +1,13 @@
+-- +goose Up
+ALTER TABLE score ADD COLUMN panel_id UUID;
+
+ALTER TABLE score ADD CONSTRAINT fk_score_panel FOREIGN KEY(panel_id) REFERENCES users(id) ON UPDATE CASCADE ON DELETE SET NULL;
+
+-- Scores entered before judges were recorded keep a NULL panel_id and are
+-- not constrained, since NULLs never conflict.
+ALTER TABLE score ADD CONSTRAINT score_panel_team_round_key UNIQUE (panel_id, team_id, round);
+
+-- +goose Down
+ALTER TABLE score DROP CONSTRAINT score_panel_team_round_key;
+
+ALTER TABLE score DROP COLUMN panel_id;
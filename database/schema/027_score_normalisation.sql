@@ -0,0 +1,37 @@
+-- +goose Up
+CREATE TABLE judging_rounds (
+    round INTEGER NOT NULL,
+    normalisation TEXT NOT NULL DEFAULT 'none' CHECK (normalisation IN ('none', 'zscore', 'minmax')),
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (round)
+);
+
+-- normalised_scores holds one row per judge scorecard with its weighted total
+-- and the total normalised with the method configured for the round. Judges
+-- are panel accounts, so both methods work within (round, panel_id):
+--   zscore: (total - mean) / stddev, 0 when the judge gave identical totals
+--   minmax: rescaled to 0..100, 50 when the judge gave identical totals
+CREATE VIEW normalised_scores AS
+WITH judge_scores AS (
+    SELECT s.id, s.team_id, s.round, s.panel_id,
+        SUM(sc.points * c.weight)::FLOAT8 AS total
+    FROM score s
+    JOIN score_criteria sc ON sc.score_id = s.id
+    JOIN rubric_criteria c ON c.id = sc.criterion_id
+    GROUP BY s.id
+)
+SELECT j.id, j.team_id, j.round, j.panel_id, j.total,
+    COALESCE(r.normalisation, 'none') AS normalisation,
+    (CASE COALESCE(r.normalisation, 'none')
+        WHEN 'zscore' THEN COALESCE((j.total - AVG(j.total) OVER w) / NULLIF(STDDEV_POP(j.total) OVER w, 0), 0)
+        WHEN 'minmax' THEN COALESCE((j.total - MIN(j.total) OVER w) / NULLIF(MAX(j.total) OVER w - MIN(j.total) OVER w, 0) * 100, 50)
+        ELSE j.total
+    END)::FLOAT8 AS normalised
+FROM judge_scores j
+LEFT JOIN judging_rounds r ON r.round = j.round
+WINDOW w AS (PARTITION BY j.round, j.panel_id);
+
+-- +goose Down
+DROP VIEW normalised_scores;
+
+DROP TABLE judging_rounds;
@@ -0,0 +1,24 @@
+-- +goose Up
+CREATE TABLE panel_conflicts (
+    id UUID NOT NULL UNIQUE,
+    panel_id UUID NOT NULL,
+    team_id UUID,
+    user_id UUID,
+    reason TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (id),
+    CHECK ((team_id IS NULL) <> (user_id IS NULL))
+);
+
+ALTER TABLE panel_conflicts ADD CONSTRAINT fk_panel_conflicts_panel FOREIGN KEY(panel_id) REFERENCES users(id) ON UPDATE CASCADE ON DELETE CASCADE;
+
+ALTER TABLE panel_conflicts ADD CONSTRAINT fk_panel_conflicts_teams FOREIGN KEY(team_id) REFERENCES teams(id) ON UPDATE CASCADE ON DELETE CASCADE;
+
+ALTER TABLE panel_conflicts ADD CONSTRAINT fk_panel_conflicts_users FOREIGN KEY(user_id) REFERENCES users(id) ON UPDATE CASCADE ON DELETE CASCADE;
+
+CREATE UNIQUE INDEX panel_conflicts_team_key ON panel_conflicts (panel_id, team_id) WHERE team_id IS NOT NULL;
+
+CREATE UNIQUE INDEX panel_conflicts_user_key ON panel_conflicts (panel_id, user_id) WHERE user_id IS NOT NULL;
+
+-- +goose Down
+DROP TABLE panel_conflicts;
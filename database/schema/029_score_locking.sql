@@ -0,0 +1,18 @@
+-- +goose Up
+ALTER TABLE judging_rounds
+    ADD COLUMN state TEXT NOT NULL DEFAULT 'open' CHECK (state IN ('open', 'locked', 'published')),
+    ADD COLUMN finalised_at TIMESTAMP,
+    ADD COLUMN published_at TIMESTAMP,
+    ADD COLUMN reopen_reason TEXT,
+    ADD COLUMN state_changed_by UUID;
+
+ALTER TABLE judging_rounds
+ADD CONSTRAINT fk_judging_rounds_state_changed_by FOREIGN KEY(state_changed_by) REFERENCES users(id) ON DELETE SET NULL;
+
+-- +goose Down
+ALTER TABLE judging_rounds
+    DROP COLUMN state_changed_by,
+    DROP COLUMN reopen_reason,
+    DROP COLUMN published_at,
+    DROP COLUMN finalised_at,
+    DROP COLUMN state;
@@ -0,0 +1,35 @@
+-- +goose Up
+CREATE TABLE score_audit (
+    id UUID NOT NULL UNIQUE,
+    score_id UUID NOT NULL,
+    team_id UUID NOT NULL,
+    round INTEGER NOT NULL,
+    panel_id UUID,
+    actor_id UUID NOT NULL,
+    action TEXT NOT NULL CHECK (action IN ('create', 'update', 'delete')),
+    before JSONB,
+    after JSONB,
+    reason TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (id)
+);
+
+CREATE INDEX idx_score_audit_team ON score_audit (team_id, created_at);
+CREATE INDEX idx_score_audit_panel ON score_audit (panel_id, created_at);
+
+-- +goose StatementBegin
+CREATE FUNCTION score_audit_append_only() RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'score_audit is append-only';
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE TRIGGER score_audit_append_only
+BEFORE UPDATE OR DELETE ON score_audit
+FOR EACH ROW EXECUTE FUNCTION score_audit_append_only();
+
+-- +goose Down
+DROP TRIGGER score_audit_append_only ON score_audit;
+DROP FUNCTION score_audit_append_only();
+DROP TABLE score_audit;
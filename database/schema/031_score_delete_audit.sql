@@ -0,0 +1,55 @@
+-- +goose Up
+-- Scores also disappear through ON DELETE CASCADE from teams, so deletes are
+-- audited by a trigger rather than by each handler. The handler passes the
+-- actor and reason through transaction-local settings; deletes without them
+-- are still logged, with no actor.
+ALTER TABLE score_audit ALTER COLUMN actor_id DROP NOT NULL;
+
+-- +goose StatementBegin
+CREATE FUNCTION score_snapshot(score_id UUID) RETURNS JSONB AS $$
+    SELECT jsonb_build_object(
+        'team_id', s.team_id,
+        'round', s.round,
+        'panel_id', s.panel_id,
+        'comment', s.comment,
+        'criteria', COALESCE(jsonb_object_agg(c.slug, sc.points) FILTER (WHERE c.slug IS NOT NULL), '{}'::JSONB),
+        'total', COALESCE(SUM(sc.points * c.weight), 0)
+    )
+    FROM score s
+    LEFT JOIN score_criteria sc ON sc.score_id = s.id
+    LEFT JOIN rubric_criteria c ON c.id = sc.criterion_id
+    WHERE s.id = score_snapshot.score_id
+    GROUP BY s.id;
+$$ LANGUAGE sql STABLE;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE FUNCTION score_audit_delete() RETURNS TRIGGER AS $$
+BEGIN
+    INSERT INTO score_audit (id, score_id, team_id, round, panel_id, actor_id, action, before, reason)
+    VALUES (
+        gen_random_uuid(), OLD.id, OLD.team_id, OLD.round, OLD.panel_id,
+        NULLIF(current_setting('devsoc.actor_id', true), '')::UUID,
+        'delete',
+        score_snapshot(OLD.id),
+        COALESCE(current_setting('devsoc.audit_reason', true), '')
+    );
+    RETURN OLD;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- BEFORE so the score's criteria are still there for the snapshot.
+CREATE TRIGGER score_audit_delete
+BEFORE DELETE ON score
+FOR EACH ROW EXECUTE FUNCTION score_audit_delete();
+
+-- +goose Down
+DROP TRIGGER score_audit_delete ON score;
+DROP FUNCTION score_audit_delete();
+DROP FUNCTION score_snapshot(UUID);
+
+ALTER TABLE score_audit DISABLE TRIGGER score_audit_append_only;
+DELETE FROM score_audit WHERE actor_id IS NULL;
+ALTER TABLE score_audit ENABLE TRIGGER score_audit_append_only;
+ALTER TABLE score_audit ALTER COLUMN actor_id SET NOT NULL;
@@ -0,0 +1,7 @@
+-- +goose Up
+-- Auto-assignment only replaces its own rows; assignments that predate this
+-- column count as manual so re-running it cannot drop them.
+ALTER TABLE panel_assignments ADD COLUMN is_auto BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- +goose Down
+ALTER TABLE panel_assignments DROP COLUMN is_auto;
@@ -0,0 +1,51 @@
+-- +goose Up
+-- minmax rescales across a whole room rather than a single judge. Judges
+-- assigned exactly the same teams for a round in panel_assignments sit on the
+-- same panel; a judge with no assignments is a room of their own. zscore
+-- stays per judge.
+CREATE OR REPLACE VIEW normalised_scores AS
+WITH judge_scores AS (
+    SELECT s.id, s.team_id, s.round, s.panel_id,
+        SUM(sc.points * c.weight)::FLOAT8 AS total
+    FROM score s
+    JOIN score_criteria sc ON sc.score_id = s.id
+    JOIN rubric_criteria c ON c.id = sc.criterion_id
+    GROUP BY s.id
+), rooms AS (
+    SELECT round, panel_id, STRING_AGG(team_id::TEXT, ',' ORDER BY team_id) AS teams
+    FROM panel_assignments
+    GROUP BY round, panel_id
+)
+SELECT j.id, j.team_id, j.round, j.panel_id, j.total,
+    COALESCE(r.normalisation, 'none') AS normalisation,
+    (CASE COALESCE(r.normalisation, 'none')
+        WHEN 'zscore' THEN COALESCE((j.total - AVG(j.total) OVER w) / NULLIF(STDDEV_POP(j.total) OVER w, 0), 0)
+        WHEN 'minmax' THEN COALESCE((j.total - MIN(j.total) OVER room) / NULLIF(MAX(j.total) OVER room - MIN(j.total) OVER room, 0) * 100, 50)
+        ELSE j.total
+    END)::FLOAT8 AS normalised
+FROM judge_scores j
+LEFT JOIN judging_rounds r ON r.round = j.round
+LEFT JOIN rooms p ON p.round = j.round AND p.panel_id = j.panel_id
+WINDOW w AS (PARTITION BY j.round, j.panel_id),
+    room AS (PARTITION BY j.round, COALESCE(p.teams, j.panel_id::TEXT));
+
+-- +goose Down
+CREATE OR REPLACE VIEW normalised_scores AS
+WITH judge_scores AS (
+    SELECT s.id, s.team_id, s.round, s.panel_id,
+        SUM(sc.points * c.weight)::FLOAT8 AS total
+    FROM score s
+    JOIN score_criteria sc ON sc.score_id = s.id
+    JOIN rubric_criteria c ON c.id = sc.criterion_id
+    GROUP BY s.id
+)
+SELECT j.id, j.team_id, j.round, j.panel_id, j.total,
+    COALESCE(r.normalisation, 'none') AS normalisation,
+    (CASE COALESCE(r.normalisation, 'none')
+        WHEN 'zscore' THEN COALESCE((j.total - AVG(j.total) OVER w) / NULLIF(STDDEV_POP(j.total) OVER w, 0), 0)
+        WHEN 'minmax' THEN COALESCE((j.total - MIN(j.total) OVER w) / NULLIF(MAX(j.total) OVER w - MIN(j.total) OVER w, 0) * 100, 50)
+        ELSE j.total
+    END)::FLOAT8 AS normalised
+FROM judge_scores j
+LEFT JOIN judging_rounds r ON r.round = j.round
+WINDOW w AS (PARTITION BY j.round, j.panel_id);
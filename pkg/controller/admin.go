@@ -270,7 +270,18 @@ func GetTeamById(c echo.Context) error {
 		})
 	}
 
-	submission, err := utils.Queries.GetSubmissionByTeamID(c.Request().Context(), teamId)
+	round, ok := submissionRound(c, team)
+	if !ok {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: "Invalid round",
+		})
+	}
+
+	submission, err := utils.Queries.GetSubmissionByRound(c.Request().Context(), db.GetSubmissionByRoundParams{
+		TeamID: teamId,
+		Round:  round,
+	})
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			submission = db.Submission{}
@@ -282,6 +293,14 @@ func GetTeamById(c echo.Context) error {
 		}
 	}
 
+	submissions, err := utils.Queries.GetTeamSubmissions(c.Request().Context(), teamId)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: err.Error(),
+		})
+	}
+
 	idea, err := utils.Queries.GetIdeaByTeamID(c.Request().Context(), teamId)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -308,7 +327,9 @@ func GetTeamById(c echo.Context) error {
 		Data: map[string]interface{}{
 			"team":         team,
 			"team_members": users,
+			"round":        round,
 			"submission":   submission,
+			"submissions":  submissions,
 			"idea":         idea,
 			"score":        score,
 		},
@@ -317,7 +338,7 @@ func GetTeamById(c echo.Context) error {
 
 func GetTeamsByTrack(c echo.Context) error {
 	ctx := c.Request().Context()
-	track := c.Param("track")
+	track := utils.TrackSlug(c.Param("track"))
 
 	teams, err := utils.Queries.GetTeamByTrack(ctx, track)
 	if err != nil {
@@ -596,89 +617,6 @@ func UpdateTeamRounds(c echo.Context) error {
 	})
 }
 
-func GetLeaderBoard(c echo.Context) error {
-	ctx := c.Request().Context()
-
-	limitParam := c.QueryParam("limit")
-	cursorParam := c.QueryParam("cursor")
-	nameParam := c.QueryParam("name")
-
-	limit := 10
-	var cursor uuid.NullUUID
-	var err error
-
-	if limitParam != "" {
-		parsedLimit, err := strconv.Atoi(limitParam)
-		if err == nil && parsedLimit > 0 {
-			limit = parsedLimit
-		}
-	}
-
-	if cursorParam != "" {
-		parsedCursor, err := uuid.Parse(cursorParam)
-		if err == nil {
-			cursor = uuid.NullUUID{UUID: parsedCursor}
-		}
-	}
-
-	rows, err := utils.Queries.GetLeaderboardWithPagination(ctx, db.GetLeaderboardWithPaginationParams{
-		Column1: cursor.UUID,
-		Limit:   int32(limit),
-		Column2: nameParam,
-	})
-	if err != nil {
-		return c.JSON(echo.ErrInternalServerError.Code, &models.Response{
-			Status:  "fail",
-			Message: "Some error occurred",
-			Data: map[string]string{
-				"error": err.Error(),
-			},
-		})
-	}
-
-	leaderboardMap := make(map[uuid.UUID]*models.TeamLeaderboard)
-	var nextCursor uuid.NullUUID
-
-	for _, row := range rows {
-		if _, exists := leaderboardMap[row.TeamID]; !exists {
-			leaderboardMap[row.TeamID] = &models.TeamLeaderboard{
-				TeamID:       row.TeamID,
-				TeamName:     row.Name,
-				Rounds:       []models.Round{},
-				OverallTotal: int(row.OverallTotal),
-			}
-		}
-
-		leaderboardMap[row.TeamID].Rounds = append(leaderboardMap[row.TeamID].Rounds, models.Round{
-			Round:          int(row.Round),
-			Design:         int(row.Design),
-			Implementation: int(row.Implementation),
-			Presentation:   int(row.Presentation),
-			Innovation:     int(row.Innovation),
-			Teamwork:       int(row.Teamwork),
-			RoundTotal:     int(row.RoundTotal),
-		})
-		nextCursor = uuid.NullUUID{UUID: row.TeamID}
-	}
-
-	leaderBoard := make([]models.TeamLeaderboard, 0, len(leaderboardMap))
-	for _, team := range leaderboardMap {
-		leaderBoard = append(leaderBoard, *team)
-	}
-
-	response := map[string]interface{}{
-		"leaderboard": leaderBoard,
-		"next_cursor": nextCursor.UUID.String(),
-	}
-
-	return c.JSON(http.StatusOK, &models.Response{
-		Status:  "success",
-		Message: "Leaderboard fetched successfully",
-		Data:    response,
-	})
-
-}
-
 func GetAllIdeas(c echo.Context) error {
 	ctx := c.Request().Context()
 	limitParam := c.QueryParam("limit")
@@ -757,6 +695,7 @@ func GetIdeasByTrack(c echo.Context) error {
 		Column1: &search,
 		Column2: cursorUUID,
 		Column3: int32(limit),
+		Column4: utils.TrackSlug(search),
 	})
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, &models.Response{
@@ -0,0 +1,538 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/CodeChefVIT/devsoc-be-24/pkg/db"
+	"github.com/CodeChefVIT/devsoc-be-24/pkg/dto"
+	logger "github.com/CodeChefVIT/devsoc-be-24/pkg/logging"
+	"github.com/CodeChefVIT/devsoc-be-24/pkg/models"
+	"github.com/CodeChefVIT/devsoc-be-24/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/labstack/echo/v4"
+)
+
+// canScoreTeam reports whether user may score the team in the given round.
+// Admins may score any team, panelists only the teams assigned to them.
+func canScoreTeam(ctx context.Context, user db.User, teamId uuid.UUID, round int32) (bool, error) {
+	if user.Role == "admin" {
+		return true, nil
+	}
+
+	return utils.Queries.IsPanelAssigned(ctx, db.IsPanelAssignedParams{
+		Round:   round,
+		PanelID: user.ID,
+		TeamID:  teamId,
+	})
+}
+
+// ownsScore reports whether user may change an existing score. Admins may
+// change any score, panelists only the ones they entered.
+func ownsScore(user db.User, score db.Score) bool {
+	return user.Role == "admin" || (score.PanelID.Valid && score.PanelID.UUID == user.ID)
+}
+
+// roundRobin spreads teams over panels, giving each team up to perTeam
+// distinct panelists on top of the ones it keeps. Teams are expected to be
+// ordered by track, so carrying the cursor across tracks keeps every
+// panelist's load balanced both overall and within each track. Conflicted and
+// already kept panelists are skipped for that team.
+func roundRobin(teams []db.GetAssignableTeamsRow, panels []db.User, perTeam int, conflicts, kept map[uuid.UUID]map[uuid.UUID]bool) map[uuid.UUID][]db.User {
+	assigned := make(map[uuid.UUID][]db.User, len(teams))
+	cursor := 0
+	for _, team := range teams {
+		for i := 0; i < len(panels) && len(kept[team.ID])+len(assigned[team.ID]) < perTeam; i++ {
+			panel := panels[cursor%len(panels)]
+			cursor++
+			if conflicts[team.ID][panel.ID] || kept[team.ID][panel.ID] {
+				continue
+			}
+			assigned[team.ID] = append(assigned[team.ID], panel)
+		}
+	}
+	return assigned
+}
+
+func GetPanelAssignments(c echo.Context) error {
+	round, ok := parseRound(c.QueryParam("round"))
+	if !ok {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: "Invalid round",
+		})
+	}
+
+	assignments, err := utils.Queries.GetRoundAssignments(c.Request().Context(), round)
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to fetch assignments",
+		})
+	}
+
+	return c.JSON(http.StatusOK, &models.Response{
+		Status:  "success",
+		Message: "Assignments fetched successfully",
+		Data:    assignments,
+	})
+}
+
+func AssignPanel(c echo.Context) error {
+	var payload models.PanelAssignment
+
+	if err := c.Bind(&payload); err != nil {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: "Invalid request body",
+		})
+	}
+
+	if err := utils.Validate.Struct(payload); err != nil {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: utils.FormatValidationErrors(err),
+		})
+	}
+
+	ctx := c.Request().Context()
+	actor := c.Get("user").(db.User)
+
+	state, err := roundState(ctx, payload.Round)
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to fetch judging round",
+		})
+	}
+	if state != "open" {
+		return c.JSON(http.StatusConflict, &models.Response{
+			Status:  "fail",
+			Message: fmt.Sprintf("Judging round %d is %s, reopen it to assign panels", payload.Round, state),
+		})
+	}
+
+	panel, err := utils.Queries.GetUser(ctx, payload.PanelID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return c.JSON(http.StatusNotFound, &models.Response{
+				Status:  "fail",
+				Message: "Panel member not found",
+			})
+		}
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to fetch panel member",
+		})
+	}
+
+	if panel.Role != "panel" {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: "User is not a panel member",
+		})
+	}
+
+	tx, err := utils.DB.Begin(ctx)
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to start transaction",
+		})
+	}
+	defer tx.Rollback(ctx)
+	qtx := utils.Queries.WithTx(tx)
+
+	for _, teamId := range payload.TeamIDs {
+		conflicts, err := teamConflicts(ctx, panel.ID, teamId)
+		if err != nil {
+			logger.Errorf(logger.DatabaseError, err.Error())
+			return c.JSON(http.StatusInternalServerError, &models.Response{
+				Status:  "fail",
+				Message: "Failed to check conflicts of interest",
+			})
+		}
+		if len(conflicts) > 0 {
+			return c.JSON(http.StatusConflict, &models.Response{
+				Status:  "fail",
+				Message: fmt.Sprintf("Panel member has a conflict of interest with team %s (%s)", teamId, conflictReasons(conflicts)),
+			})
+		}
+
+		id, _ := uuid.NewV7()
+		if err := qtx.CreatePanelAssignment(ctx, db.CreatePanelAssignmentParams{
+			ID:         id,
+			Round:      payload.Round,
+			PanelID:    panel.ID,
+			TeamID:     teamId,
+			AssignedBy: actor.ID,
+		}); err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+				return c.JSON(http.StatusNotFound, &models.Response{
+					Status:  "fail",
+					Message: fmt.Sprintf("Team %s not found", teamId),
+				})
+			}
+			logger.Errorf(logger.DatabaseError, err.Error())
+			return c.JSON(http.StatusInternalServerError, &models.Response{
+				Status:  "fail",
+				Message: "Failed to assign panel member",
+			})
+		}
+
+		if err := recordAudit(ctx, qtx, actor, "panel.assign", teamId,
+			uuid.NullUUID{UUID: panel.ID, Valid: true},
+			fmt.Sprintf("round %d: %s", payload.Round, panel.Email)); err != nil {
+			logger.Errorf(logger.DatabaseError, err.Error())
+			return c.JSON(http.StatusInternalServerError, &models.Response{
+				Status:  "fail",
+				Message: "Failed to record audit log",
+			})
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to assign panel member",
+		})
+	}
+
+	return c.JSON(http.StatusOK, &models.Response{
+		Status:  "success",
+		Message: "Panel member assigned successfully",
+	})
+}
+
+func UnassignPanel(c echo.Context) error {
+	var payload models.PanelAssignment
+
+	if err := c.Bind(&payload); err != nil {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: "Invalid request body",
+		})
+	}
+
+	if err := utils.Validate.Struct(payload); err != nil {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: utils.FormatValidationErrors(err),
+		})
+	}
+
+	ctx := c.Request().Context()
+	actor := c.Get("user").(db.User)
+
+	tx, err := utils.DB.Begin(ctx)
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to start transaction",
+		})
+	}
+	defer tx.Rollback(ctx)
+	qtx := utils.Queries.WithTx(tx)
+
+	for _, teamId := range payload.TeamIDs {
+		if err := qtx.DeletePanelAssignment(ctx, db.DeletePanelAssignmentParams{
+			Round:   payload.Round,
+			PanelID: payload.PanelID,
+			TeamID:  teamId,
+		}); err != nil {
+			logger.Errorf(logger.DatabaseError, err.Error())
+			return c.JSON(http.StatusInternalServerError, &models.Response{
+				Status:  "fail",
+				Message: "Failed to unassign panel member",
+			})
+		}
+
+		if err := recordAudit(ctx, qtx, actor, "panel.unassign", teamId,
+			uuid.NullUUID{UUID: payload.PanelID, Valid: true},
+			fmt.Sprintf("round %d", payload.Round)); err != nil {
+			logger.Errorf(logger.DatabaseError, err.Error())
+			return c.JSON(http.StatusInternalServerError, &models.Response{
+				Status:  "fail",
+				Message: "Failed to record audit log",
+			})
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to unassign panel member",
+		})
+	}
+
+	return c.JSON(http.StatusOK, &models.Response{
+		Status:  "success",
+		Message: "Panel member unassigned successfully",
+	})
+}
+
+// AutoAssignPanels replaces the round's automatic assignments with a
+// round-robin distribution of every eligible team over the active panel
+// members. Assignments a judge has already scored are always kept, and manual
+// ones are kept unless replace_manual is set; kept assignments count towards
+// panels_per_team.
+func AutoAssignPanels(c echo.Context) error {
+	var payload models.AutoAssignPanels
+
+	if err := c.Bind(&payload); err != nil {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: "Invalid request body",
+		})
+	}
+
+	if err := utils.Validate.Struct(payload); err != nil {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: utils.FormatValidationErrors(err),
+		})
+	}
+
+	if payload.PanelsPerTeam == 0 {
+		payload.PanelsPerTeam = 1
+	}
+
+	ctx := c.Request().Context()
+	actor := c.Get("user").(db.User)
+
+	state, err := roundState(ctx, payload.Round)
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to fetch judging round",
+		})
+	}
+	if state != "open" {
+		return c.JSON(http.StatusConflict, &models.Response{
+			Status:  "fail",
+			Message: fmt.Sprintf("Judging round %d is %s, reopen it to reassign panels", payload.Round, state),
+		})
+	}
+
+	panels, err := utils.Queries.GetPanelUsers(ctx)
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to fetch panel members",
+		})
+	}
+
+	if payload.PanelsPerTeam > len(panels) {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: fmt.Sprintf("Only %d panel members are available", len(panels)),
+		})
+	}
+
+	teams, err := utils.Queries.GetAssignableTeams(ctx, payload.Round)
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to fetch teams",
+		})
+	}
+
+	pairs, err := utils.Queries.ListConflictPairs(ctx, utils.Config.ConflictRegNoLen)
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to fetch conflicts of interest",
+		})
+	}
+
+	tx, err := utils.DB.Begin(ctx)
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to start transaction",
+		})
+	}
+	defer tx.Rollback(ctx)
+	qtx := utils.Queries.WithTx(tx)
+
+	if err := qtx.DeleteRoundAssignments(ctx, db.DeleteRoundAssignmentsParams{
+		Round:         payload.Round,
+		ReplaceManual: payload.ReplaceManual,
+	}); err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to clear assignments",
+		})
+	}
+
+	remaining, err := qtx.GetRoundAssignments(ctx, payload.Round)
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to fetch assignments",
+		})
+	}
+
+	kept := make(map[uuid.UUID]map[uuid.UUID]bool)
+	for _, assignment := range remaining {
+		if kept[assignment.TeamID] == nil {
+			kept[assignment.TeamID] = make(map[uuid.UUID]bool)
+		}
+		kept[assignment.TeamID][assignment.PanelID] = true
+	}
+
+	assigned := roundRobin(teams, panels, payload.PanelsPerTeam, conflictSet(pairs), kept)
+
+	loads := make(map[uuid.UUID]*dto.PanelLoad, len(panels))
+	for _, panel := range panels {
+		loads[panel.ID] = &dto.PanelLoad{
+			PanelID: panel.ID.String(),
+			Name:    panel.FirstName + " " + panel.LastName,
+			Email:   panel.Email,
+			Tracks:  map[string]int{},
+		}
+	}
+
+	understaffed := []string{}
+	for _, team := range teams {
+		if len(kept[team.ID])+len(assigned[team.ID]) < payload.PanelsPerTeam {
+			understaffed = append(understaffed, team.Name)
+		}
+
+		for panelID := range kept[team.ID] {
+			if load, ok := loads[panelID]; ok {
+				load.Teams++
+				load.Tracks[team.Track]++
+			}
+		}
+		if len(assigned[team.ID]) == 0 {
+			continue
+		}
+
+		emails := make([]string, 0, payload.PanelsPerTeam)
+		for _, panel := range assigned[team.ID] {
+			id, _ := uuid.NewV7()
+			if err := qtx.CreatePanelAssignment(ctx, db.CreatePanelAssignmentParams{
+				ID:         id,
+				Round:      payload.Round,
+				PanelID:    panel.ID,
+				TeamID:     team.ID,
+				AssignedBy: actor.ID,
+				IsAuto:     true,
+			}); err != nil {
+				logger.Errorf(logger.DatabaseError, err.Error())
+				return c.JSON(http.StatusInternalServerError, &models.Response{
+					Status:  "fail",
+					Message: "Failed to assign panel members",
+				})
+			}
+
+			loads[panel.ID].Teams++
+			loads[panel.ID].Tracks[team.Track]++
+			emails = append(emails, panel.Email)
+		}
+
+		if err := recordAudit(ctx, qtx, actor, "panel.assign.auto", team.ID, uuid.NullUUID{},
+			fmt.Sprintf("round %d: %s", payload.Round, strings.Join(emails, ", "))); err != nil {
+			logger.Errorf(logger.DatabaseError, err.Error())
+			return c.JSON(http.StatusInternalServerError, &models.Response{
+				Status:  "fail",
+				Message: "Failed to record audit log",
+			})
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to assign panel members",
+		})
+	}
+
+	summary := make([]dto.PanelLoad, 0, len(panels))
+	for _, panel := range panels {
+		summary = append(summary, *loads[panel.ID])
+	}
+
+	return c.JSON(http.StatusOK, &models.Response{
+		Status:  "success",
+		Message: fmt.Sprintf("Assigned %d teams to %d panel members", len(teams), len(panels)),
+		Data: map[string]interface{}{
+			"panels":       summary,
+			"understaffed": understaffed,
+		},
+	})
+}
+
+func GetPanelQueue(c echo.Context) error {
+	ctx := c.Request().Context()
+	user := c.Get("user").(db.User)
+
+	var round int32
+	if param := c.QueryParam("round"); param != "" {
+		var ok bool
+		round, ok = parseRound(param)
+		if !ok {
+			return c.JSON(http.StatusBadRequest, &models.Response{
+				Status:  "fail",
+				Message: "Invalid round",
+			})
+		}
+	}
+
+	rows, err := utils.Queries.GetPanelQueue(ctx, db.GetPanelQueueParams{
+		PanelID: user.ID,
+		Round:   round,
+	})
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to fetch judging queue",
+		})
+	}
+
+	queue := make([]dto.QueueEntry, 0, len(rows))
+	for _, row := range rows {
+		status := "pending"
+		if row.Scored {
+			status = "scored"
+		}
+		queue = append(queue, dto.QueueEntry{
+			Round:         row.Round,
+			TeamID:        row.TeamID.String(),
+			TeamName:      row.Name,
+			Track:         row.Track,
+			HasSubmission: row.SubmissionID.Valid,
+			SubmissionURL: fmt.Sprintf("/panel/getsubmission/%s?round=%d", row.TeamID, row.Round),
+			Status:        status,
+		})
+	}
+
+	return c.JSON(http.StatusOK, &models.Response{
+		Status:  "success",
+		Message: "Judging queue fetched successfully",
+		Data:    queue,
+	})
+}
@@ -0,0 +1,418 @@
+package controller
+
+import (
+	"archive/zip"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	"github.com/CodeChefVIT/devsoc-be-24/pkg/db"
+	"github.com/CodeChefVIT/devsoc-be-24/pkg/dto"
+	logger "github.com/CodeChefVIT/devsoc-be-24/pkg/logging"
+	"github.com/CodeChefVIT/devsoc-be-24/pkg/models"
+	"github.com/CodeChefVIT/devsoc-be-24/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/labstack/echo/v4"
+)
+
+// attachmentTypes maps each accepted extension to the type sniffed from the
+// file contents and the content type the file is served with.
+var attachmentTypes = map[string]struct{ sniffed, contentType string }{
+	".pdf":  {"application/pdf", "application/pdf"},
+	".pptx": {"application/zip", "application/vnd.openxmlformats-officedocument.presentationml.presentation"},
+	".mp4":  {"video/mp4", "video/mp4"},
+	".webm": {"video/webm", "video/webm"},
+}
+
+// isPresentation reports whether the zip archive in r is a PowerPoint file,
+// which the content sniffer alone cannot tell apart from any other zip.
+func isPresentation(r io.ReaderAt, size int64) bool {
+	archive, err := zip.NewReader(r, size)
+	if err != nil {
+		return false
+	}
+
+	var contentTypes, presentation bool
+	for _, f := range archive.File {
+		switch {
+		case f.Name == "[Content_Types].xml":
+			contentTypes = true
+		case f.Name == "ppt/presentation.xml":
+			presentation = true
+		}
+	}
+	return contentTypes && presentation
+}
+
+func attachmentDTO(a db.SubmissionAttachment) dto.Attachment {
+	return dto.Attachment{
+		ID:          a.ID.String(),
+		FileName:    a.FileName,
+		ContentType: a.ContentType,
+		Size:        a.Size,
+		DownloadURL: "/panel/attachments/" + a.ID.String(),
+		CreatedAt:   a.CreatedAt.Time,
+	}
+}
+
+func listAttachments(c echo.Context, teamId uuid.UUID) error {
+	ctx := c.Request().Context()
+
+	team, err := utils.Queries.GetTeamByTeamId(ctx, teamId)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return c.JSON(http.StatusNotFound, &models.Response{
+				Status:  "fail",
+				Message: "Team not found",
+			})
+		}
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to fetch team",
+		})
+	}
+
+	round, ok := submissionRound(c, team)
+	if !ok {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: "Invalid round",
+		})
+	}
+
+	attachments, err := utils.Queries.GetSubmissionAttachments(ctx, db.GetSubmissionAttachmentsParams{
+		TeamID: teamId,
+		Round:  round,
+	})
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to fetch attachments",
+		})
+	}
+
+	data := make([]dto.Attachment, 0, len(attachments))
+	for _, a := range attachments {
+		data = append(data, attachmentDTO(a))
+	}
+
+	return c.JSON(http.StatusOK, &models.Response{
+		Status:  "success",
+		Message: "Attachments fetched successfully",
+		Data:    data,
+	})
+}
+
+func UploadSubmissionAttachment(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	user, ok := c.Get("user").(db.User)
+	if !ok || !user.TeamID.Valid || !user.IsLeader {
+		return c.JSON(http.StatusForbidden, &models.Response{
+			Status:  "fail",
+			Message: "User does not belong to any team or is not team leader",
+		})
+	}
+	teamId := user.TeamID.UUID
+
+	file, err := c.FormFile("file")
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: "file is required",
+		})
+	}
+
+	maxSize := utils.Config.AttachmentMaxSize
+	if file.Size > maxSize {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: fmt.Sprintf("Attachments must be smaller than %dMB", maxSize>>20),
+		})
+	}
+
+	name := filepath.Base(file.Filename)
+	ext := strings.ToLower(filepath.Ext(name))
+	kind, ok := attachmentTypes[ext]
+	if !ok {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: "Attachments must be PDF, PPTX, MP4 or WebM files",
+		})
+	}
+
+	src, err := file.Open()
+	if err != nil {
+		logger.Errorf(logger.InternalError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to read attachment",
+		})
+	}
+	defer src.Close()
+
+	head := make([]byte, 512)
+	n, err := io.ReadFull(src, head)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: "Failed to read attachment",
+		})
+	}
+
+	if http.DetectContentType(head[:n]) != kind.sniffed ||
+		(ext == ".pptx" && !isPresentation(src, file.Size)) {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: "File contents do not match its extension",
+		})
+	}
+
+	if _, err := src.Seek(0, io.SeekStart); err != nil {
+		logger.Errorf(logger.InternalError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to read attachment",
+		})
+	}
+
+	round, _ := c.Get("round").(int32)
+
+	if _, err := utils.Queries.GetSubmissionByRound(ctx, db.GetSubmissionByRoundParams{
+		TeamID: teamId,
+		Round:  round,
+	}); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return c.JSON(http.StatusNotFound, &models.Response{
+				Status:  "fail",
+				Message: "Create a submission before uploading attachments",
+			})
+		}
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to fetch submission",
+		})
+	}
+
+	id, _ := uuid.NewV7()
+	key := "attachments/" + teamId.String() + "/" + id.String() + ext
+
+	size, err := utils.Store.Save(ctx, key, io.LimitReader(src, maxSize+1))
+	if err != nil {
+		logger.Errorf(logger.InternalError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to store attachment",
+		})
+	}
+
+	if size > maxSize {
+		utils.Store.Delete(ctx, key)
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: fmt.Sprintf("Attachments must be smaller than %dMB", maxSize>>20),
+		})
+	}
+
+	tx, err := utils.DB.Begin(ctx)
+	if err != nil {
+		utils.Store.Delete(ctx, key)
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to start transaction",
+		})
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := utils.Queries.WithTx(tx)
+
+	// Concurrent uploads of the same team wait here, so each one sees the
+	// usage of the uploads committed before it.
+	if err := qtx.LockTeam(ctx, teamId); err != nil {
+		utils.Store.Delete(ctx, key)
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to check attachment quota",
+		})
+	}
+
+	used, err := qtx.GetTeamAttachmentUsage(ctx, teamId)
+	if err != nil {
+		utils.Store.Delete(ctx, key)
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to check attachment quota",
+		})
+	}
+
+	if used+size > utils.Config.AttachmentQuota {
+		utils.Store.Delete(ctx, key)
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: fmt.Sprintf("Team attachment quota of %dMB exceeded", utils.Config.AttachmentQuota>>20),
+		})
+	}
+
+	attachment, err := qtx.CreateSubmissionAttachment(ctx, db.CreateSubmissionAttachmentParams{
+		ID:          id,
+		TeamID:      teamId,
+		Round:       round,
+		FileName:    name,
+		ContentType: kind.contentType,
+		Size:        size,
+		StorageKey:  key,
+		UploadedBy:  uuid.NullUUID{UUID: user.ID, Valid: true},
+	})
+	if err != nil {
+		utils.Store.Delete(ctx, key)
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to save attachment",
+		})
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		utils.Store.Delete(ctx, key)
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to save attachment",
+		})
+	}
+
+	return c.JSON(http.StatusCreated, &models.Response{
+		Status:  "success",
+		Message: "Attachment uploaded successfully",
+		Data:    attachmentDTO(attachment),
+	})
+}
+
+func GetUserAttachments(c echo.Context) error {
+	user, ok := c.Get("user").(db.User)
+	if !ok || !user.TeamID.Valid {
+		return c.JSON(http.StatusForbidden, &models.Response{
+			Status:  "fail",
+			Message: "User does not belong to any team",
+		})
+	}
+
+	return listAttachments(c, user.TeamID.UUID)
+}
+
+func DeleteSubmissionAttachment(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	user, ok := c.Get("user").(db.User)
+	if !ok || !user.TeamID.Valid || !user.IsLeader {
+		return c.JSON(http.StatusForbidden, &models.Response{
+			Status:  "fail",
+			Message: "User does not belong to any team or is not team leader",
+		})
+	}
+
+	attachmentId, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: "Invalid attachment ID format",
+		})
+	}
+
+	attachment, err := utils.Queries.GetSubmissionAttachment(ctx, attachmentId)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to fetch attachment",
+		})
+	}
+
+	if err != nil || attachment.TeamID != user.TeamID.UUID {
+		return c.JSON(http.StatusNotFound, &models.Response{
+			Status:  "fail",
+			Message: "Attachment not found",
+		})
+	}
+
+	if err := utils.Queries.DeleteSubmissionAttachment(ctx, attachment.ID); err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to delete attachment",
+		})
+	}
+
+	if err := utils.Store.Delete(ctx, attachment.StorageKey); err != nil {
+		logger.Errorf(logger.InternalError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &models.Response{
+		Status:  "success",
+		Message: "Attachment deleted successfully",
+	})
+}
+
+func GetTeamAttachments(c echo.Context) error {
+	teamId, err := uuid.Parse(c.Param("teamId"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: "Invalid team ID format",
+		})
+	}
+
+	return listAttachments(c, teamId)
+}
+
+func DownloadAttachment(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	attachmentId, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: "Invalid attachment ID format",
+		})
+	}
+
+	attachment, err := utils.Queries.GetSubmissionAttachment(ctx, attachmentId)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return c.JSON(http.StatusNotFound, &models.Response{
+				Status:  "fail",
+				Message: "Attachment not found",
+			})
+		}
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to fetch attachment",
+		})
+	}
+
+	file, err := utils.Store.Open(ctx, attachment.StorageKey)
+	if err != nil {
+		logger.Errorf(logger.InternalError, err.Error())
+		return c.JSON(http.StatusNotFound, &models.Response{
+			Status:  "fail",
+			Message: "Attachment file is missing",
+		})
+	}
+	defer file.Close()
+
+	c.Response().Header().Set(echo.HeaderContentDisposition,
+		mime.FormatMediaType("attachment", map[string]string{"filename": attachment.FileName}))
+	return c.Stream(http.StatusOK, attachment.ContentType, file)
+}
@@ -0,0 +1,196 @@
+package controller
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/CodeChefVIT/devsoc-be-24/pkg/db"
+	"github.com/CodeChefVIT/devsoc-be-24/pkg/dto"
+	logger "github.com/CodeChefVIT/devsoc-be-24/pkg/logging"
+	"github.com/CodeChefVIT/devsoc-be-24/pkg/models"
+	"github.com/CodeChefVIT/devsoc-be-24/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// recordAudit stores an admin action. Pass the transaction-bound queries so the
+// log entry is only kept if the action itself commits. Actions that are not
+// about a team, such as judging round transitions, pass uuid.Nil as teamID.
+func recordAudit(ctx context.Context, q *db.Queries, actor db.User, action string, teamID uuid.UUID, targetID uuid.NullUUID, details string) error {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return err
+	}
+
+	return q.CreateAuditLog(ctx, db.CreateAuditLogParams{
+		ID:       id,
+		ActorID:  actor.ID,
+		Action:   action,
+		TeamID:   uuid.NullUUID{UUID: teamID, Valid: teamID != uuid.Nil},
+		TargetID: targetID,
+		Details:  details,
+	})
+}
+
+// recordScoreAudit appends a score create or update to the score audit log.
+// before is the snapshot taken ahead of an update; the after snapshot is read
+// from q, so call it after the change within the same transaction. Deletes
+// are logged by the score_audit_delete trigger instead, see
+// setScoreAuditContext.
+func recordScoreAudit(ctx context.Context, q *db.Queries, actor db.User, action, reason string, score db.Score, before []byte) error {
+	after, err := q.GetScoreSnapshot(ctx, score.ID)
+	if err != nil {
+		return err
+	}
+
+	id, err := uuid.NewV7()
+	if err != nil {
+		return err
+	}
+
+	return q.CreateScoreAudit(ctx, db.CreateScoreAuditParams{
+		ID:      id,
+		ScoreID: score.ID,
+		TeamID:  score.TeamID,
+		Round:   score.Round,
+		PanelID: score.PanelID,
+		ActorID: uuid.NullUUID{UUID: actor.ID, Valid: true},
+		Action:  action,
+		Before:  before,
+		After:   after,
+		Reason:  reason,
+	})
+}
+
+// setScoreAuditContext names the actor and reason the score_audit_delete
+// trigger records for scores deleted in the rest of q's transaction, whether
+// deleted directly or through the cascade from their team. It has no effect
+// outside a transaction.
+func setScoreAuditContext(ctx context.Context, q *db.Queries, actor db.User, reason string) error {
+	return q.SetScoreAuditContext(ctx, db.SetScoreAuditContextParams{
+		ActorID: actor.ID.String(),
+		Reason:  reason,
+	})
+}
+
+func GetAuditLogs(c echo.Context) error {
+	ctx := c.Request().Context()
+	teamParam := c.QueryParam("team_id")
+
+	if teamParam != "" {
+		teamId, err := uuid.Parse(teamParam)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, &models.Response{
+				Status:  "fail",
+				Message: "Invalid team ID format",
+			})
+		}
+
+		logs, err := utils.Queries.GetAuditLogsByTeam(ctx, uuid.NullUUID{UUID: teamId, Valid: true})
+		if err != nil {
+			logger.Errorf(logger.DatabaseError, err.Error())
+			return c.JSON(http.StatusInternalServerError, &models.Response{
+				Status:  "fail",
+				Message: "Failed to fetch audit logs",
+			})
+		}
+
+		return c.JSON(http.StatusOK, &models.Response{
+			Status:  "success",
+			Message: "Audit logs fetched successfully",
+			Data:    logs,
+		})
+	}
+
+	limit, err := strconv.Atoi(c.QueryParam("limit"))
+	if err != nil || limit <= 0 {
+		limit = 50
+	}
+
+	logs, err := utils.Queries.GetAuditLogs(ctx, int32(limit))
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to fetch audit logs",
+		})
+	}
+
+	return c.JSON(http.StatusOK, &models.Response{
+		Status:  "success",
+		Message: "Audit logs fetched successfully",
+		Data:    logs,
+	})
+}
+
+// GetScoreAudit browses the score audit log, newest first, optionally
+// narrowed to one team (?team_id=) and/or one judge (?judge_id=).
+func GetScoreAudit(c echo.Context) error {
+	ctx := c.Request().Context()
+	var params db.GetScoreAuditParams
+
+	for param, target := range map[string]*uuid.NullUUID{
+		"team_id":  &params.TeamID,
+		"judge_id": &params.PanelID,
+	} {
+		value := c.QueryParam(param)
+		if value == "" {
+			continue
+		}
+		id, err := uuid.Parse(value)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, &models.Response{
+				Status:  "fail",
+				Message: "Invalid " + param + " format",
+			})
+		}
+		*target = uuid.NullUUID{UUID: id, Valid: true}
+	}
+
+	limit, err := strconv.Atoi(c.QueryParam("limit"))
+	if err != nil || limit <= 0 {
+		limit = 50
+	}
+	params.RowLimit = int32(limit)
+
+	rows, err := utils.Queries.GetScoreAudit(ctx, params)
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to fetch score audit log",
+		})
+	}
+
+	entries := make([]dto.ScoreAuditEntry, 0, len(rows))
+	for _, row := range rows {
+		entry := dto.ScoreAuditEntry{
+			ID:        row.ID.String(),
+			ScoreID:   row.ScoreID.String(),
+			TeamID:    row.TeamID.String(),
+			TeamName:  getSafeString(row.TeamName),
+			Round:     row.Round,
+			Judge:     getSafeString(row.Judge),
+			Actor:     getSafeString(row.Actor),
+			Action:    row.Action,
+			Before:    row.Before,
+			After:     row.After,
+			Reason:    row.Reason,
+			CreatedAt: row.CreatedAt.Time,
+		}
+		if row.PanelID.Valid {
+			entry.PanelID = row.PanelID.UUID.String()
+		}
+		if row.ActorID.Valid {
+			entry.ActorID = row.ActorID.UUID.String()
+		}
+		entries = append(entries, entry)
+	}
+
+	return c.JSON(http.StatusOK, &models.Response{
+		Status:  "success",
+		Message: "Score audit log fetched successfully",
+		Data:    entries,
+	})
+}
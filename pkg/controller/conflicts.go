@@ -0,0 +1,231 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/CodeChefVIT/devsoc-be-24/pkg/db"
+	logger "github.com/CodeChefVIT/devsoc-be-24/pkg/logging"
+	"github.com/CodeChefVIT/devsoc-be-24/pkg/models"
+	"github.com/CodeChefVIT/devsoc-be-24/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/labstack/echo/v4"
+)
+
+// teamConflicts lists why a judge may not score a team: a declared conflict
+// with the team, a declared conflict with one of its members, or a member
+// sharing the judge's registration-number prefix (same batch and branch).
+func teamConflicts(ctx context.Context, panelId, teamId uuid.UUID) ([]db.GetTeamConflictsRow, error) {
+	return utils.Queries.GetTeamConflicts(ctx, db.GetTeamConflictsParams{
+		PanelID:   panelId,
+		TeamID:    uuid.NullUUID{UUID: teamId, Valid: true},
+		PrefixLen: utils.Config.ConflictRegNoLen,
+	})
+}
+
+func conflictReasons(conflicts []db.GetTeamConflictsRow) string {
+	reasons := make([]string, 0, len(conflicts))
+	for _, conflict := range conflicts {
+		reasons = append(reasons, fmt.Sprintf("%s: %s", conflict.Reason, conflict.Detail))
+	}
+	return strings.Join(reasons, "; ")
+}
+
+// conflictSet indexes detected conflicts by team, then panelist.
+func conflictSet(pairs []db.ListConflictPairsRow) map[uuid.UUID]map[uuid.UUID]bool {
+	set := make(map[uuid.UUID]map[uuid.UUID]bool)
+	for _, pair := range pairs {
+		if set[pair.TeamID] == nil {
+			set[pair.TeamID] = make(map[uuid.UUID]bool)
+		}
+		set[pair.TeamID][pair.PanelID] = true
+	}
+	return set
+}
+
+func GetPanelConflicts(c echo.Context) error {
+	ctx := c.Request().Context()
+	user := c.Get("user").(db.User)
+
+	declared, err := utils.Queries.GetPanelConflicts(ctx, user.ID)
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to fetch conflicts",
+		})
+	}
+
+	pairs, err := utils.Queries.ListConflictPairs(ctx, utils.Config.ConflictRegNoLen)
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to fetch conflicts",
+		})
+	}
+
+	detected := []db.ListConflictPairsRow{}
+	for _, pair := range pairs {
+		if pair.PanelID == user.ID {
+			detected = append(detected, pair)
+		}
+	}
+
+	return c.JSON(http.StatusOK, &models.Response{
+		Status:  "success",
+		Message: "Conflicts fetched successfully",
+		Data: map[string]interface{}{
+			"declared": declared,
+			"detected": detected,
+		},
+	})
+}
+
+func DeclarePanelConflict(c echo.Context) error {
+	var payload models.PanelConflict
+
+	if err := c.Bind(&payload); err != nil {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: "Invalid request body",
+		})
+	}
+
+	if err := utils.Validate.Struct(payload); err != nil {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: utils.FormatValidationErrors(err),
+		})
+	}
+
+	if (payload.TeamID == uuid.Nil) == (payload.UserID == uuid.Nil) {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: "Exactly one of team_id or user_id is required",
+		})
+	}
+
+	ctx := c.Request().Context()
+	user := c.Get("user").(db.User)
+
+	tx, err := utils.DB.Begin(ctx)
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to start transaction",
+		})
+	}
+	defer tx.Rollback(ctx)
+	qtx := utils.Queries.WithTx(tx)
+
+	id, _ := uuid.NewV7()
+	conflict, err := qtx.CreatePanelConflict(ctx, db.CreatePanelConflictParams{
+		ID:      id,
+		PanelID: user.ID,
+		TeamID:  uuid.NullUUID{UUID: payload.TeamID, Valid: payload.TeamID != uuid.Nil},
+		UserID:  uuid.NullUUID{UUID: payload.UserID, Valid: payload.UserID != uuid.Nil},
+		Reason:  payload.Reason,
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return c.JSON(http.StatusConflict, &models.Response{
+				Status:  "fail",
+				Message: "Conflict already declared",
+			})
+		}
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			return c.JSON(http.StatusNotFound, &models.Response{
+				Status:  "fail",
+				Message: "Team or user not found",
+			})
+		}
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to declare conflict",
+		})
+	}
+
+	removed, err := qtx.DeleteConflictedAssignments(ctx, conflict.ID)
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to remove conflicted assignments",
+		})
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to declare conflict",
+		})
+	}
+
+	return c.JSON(http.StatusCreated, &models.Response{
+		Status:  "success",
+		Message: fmt.Sprintf("Conflict declared, %d assignments removed", removed),
+		Data:    conflict,
+	})
+}
+
+func DeletePanelConflict(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: "Invalid conflict ID format",
+		})
+	}
+
+	user := c.Get("user").(db.User)
+
+	deleted, err := utils.Queries.DeletePanelConflict(c.Request().Context(), db.DeletePanelConflictParams{
+		ID:      id,
+		PanelID: user.ID,
+	})
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to delete conflict",
+		})
+	}
+
+	if deleted == 0 {
+		return c.JSON(http.StatusNotFound, &models.Response{
+			Status:  "fail",
+			Message: "Conflict not found",
+		})
+	}
+
+	return c.JSON(http.StatusOK, &models.Response{
+		Status:  "success",
+		Message: "Conflict deleted successfully",
+	})
+}
+
+func ListConflicts(c echo.Context) error {
+	pairs, err := utils.Queries.ListConflictPairs(c.Request().Context(), utils.Config.ConflictRegNoLen)
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to fetch conflicts",
+		})
+	}
+
+	return c.JSON(http.StatusOK, &models.Response{
+		Status:  "success",
+		Message: "Conflicts fetched successfully",
+		Data:    pairs,
+	})
+}
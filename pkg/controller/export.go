@@ -2,9 +2,12 @@ package controller
 
 import (
 	"encoding/csv"
+	"fmt"
 	"net/http"
 	"os"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/CodeChefVIT/devsoc-be-24/pkg/models"
 	"github.com/CodeChefVIT/devsoc-be-24/pkg/utils"
@@ -141,7 +144,7 @@ func ExportTeams(c echo.Context) error {
 		"ID", "TeamName", "TeamCode", "NumberOfPeople", "RoundQualified",
 		"IdeaId", "IdeaTitle", "IdeaDescription", "IdeaTrack",
 		"SubmissionId", "SubmissionTitle", "SubmissionDescription", "SubmissionTrack", "GitHubLink", "FigmaLink", "OtherLink",
-		"ScoreId", "DesignScore", "ImplementationScore", "PresentationScore", "ScoreRound",
+		"ScoreId", "ScoreRound", "ScoreTotal", "ScoreJudge",
 	}
 	if err := csvWriter.Write(headers); err != nil {
 		return c.JSON(http.StatusInternalServerError, &models.Response{
@@ -175,10 +178,9 @@ func ExportTeams(c echo.Context) error {
 					submission.FigmaLink,
 					submission.OtherLink,
 					score.ID.String(),
-					strconv.Itoa(int(score.Design)),
-					strconv.Itoa(int(score.Implementation)),
-					strconv.Itoa(int(score.Presentation)),
 					strconv.Itoa(int(score.Round)),
+					strconv.FormatFloat(score.Total, 'f', 2, 64),
+					getSafeString(score.Judge),
 				}
 
 				if err := csvWriter.Write(record); err != nil {
@@ -210,7 +212,6 @@ func ExportTeams(c echo.Context) error {
 				"NA",
 				"NA",
 				"NA",
-				"NA",
 			}
 
 			if err := csvWriter.Write(record); err != nil {
@@ -233,3 +234,150 @@ func ExportTeams(c echo.Context) error {
 
 	return c.Attachment("teams.csv", "teams.csv")
 }
+
+func ExportIntegrity(c echo.Context) error {
+	reports, err := integrityReports(c.Request().Context(), c.QueryParam("refresh") == "true")
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to fetch submissions",
+		})
+	}
+
+	file, err := os.Create("integrity.csv")
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to create CSV file",
+		})
+	}
+	defer file.Close()
+
+	csvWriter := csv.NewWriter(file)
+
+	headers := []string{
+		"TeamID", "TeamName", "Repository", "Flagged", "Error",
+		"CommitCount", "HistoryTruncated", "CommitsBeforeStart", "FirstCommitAt",
+		"LargeInitialCommits", "OutsideContributors",
+	}
+	if err := csvWriter.Write(headers); err != nil {
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to write CSV headers",
+		})
+	}
+
+	for _, report := range reports {
+		var firstCommit string
+		if report.FirstCommitAt != nil {
+			firstCommit = report.FirstCommitAt.Format(time.RFC3339)
+		}
+
+		largeCommits := make([]string, 0, len(report.LargeInitialCommits))
+		for _, commit := range report.LargeInitialCommits {
+			largeCommits = append(largeCommits, fmt.Sprintf("%.7s (+%d)", commit.SHA, commit.Additions))
+		}
+
+		outsiders := make([]string, 0, len(report.OutsideContributors))
+		for _, contributor := range report.OutsideContributors {
+			outsiders = append(outsiders, fmt.Sprintf("%s (%d)", contributor.Login, contributor.Commits))
+		}
+
+		record := []string{
+			report.TeamID,
+			report.TeamName,
+			report.Repository,
+			strconv.FormatBool(report.Flagged),
+			report.Error,
+			strconv.Itoa(report.CommitCount),
+			strconv.FormatBool(report.HistoryTruncated),
+			strconv.Itoa(report.CommitsBeforeStart),
+			firstCommit,
+			strings.Join(largeCommits, "; "),
+			strings.Join(outsiders, "; "),
+		}
+
+		if err := csvWriter.Write(record); err != nil {
+			return c.JSON(http.StatusInternalServerError, &models.Response{
+				Status:  "fail",
+				Message: "Failed to write CSV record",
+			})
+		}
+	}
+
+	csvWriter.Flush()
+
+	if err := csvWriter.Error(); err != nil {
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to flush CSV writer",
+		})
+	}
+
+	return c.Attachment("integrity.csv", "integrity.csv")
+}
+
+func ExportScores(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	scores, err := utils.Queries.GetScoreExport(ctx)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to fetch scores",
+		})
+	}
+
+	file, err := os.Create("scores.csv")
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to create CSV file",
+		})
+	}
+	defer file.Close()
+
+	csvWriter := csv.NewWriter(file)
+
+	headers := []string{
+		"ScoreId", "TeamId", "TeamName", "Round", "Judge",
+		"RawTotal", "Normalisation", "NormalisedTotal",
+	}
+	if err := csvWriter.Write(headers); err != nil {
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to write CSV headers",
+		})
+	}
+
+	for _, score := range scores {
+		record := []string{
+			score.ID.String(),
+			score.TeamID.String(),
+			score.Name,
+			strconv.Itoa(int(score.Round)),
+			getSafeString(score.Judge),
+			strconv.FormatFloat(score.Total, 'f', 2, 64),
+			score.Normalisation,
+			strconv.FormatFloat(score.Normalised, 'f', 4, 64),
+		}
+
+		if err := csvWriter.Write(record); err != nil {
+			return c.JSON(http.StatusInternalServerError, &models.Response{
+				Status:  "fail",
+				Message: "Failed to write CSV record",
+			})
+		}
+	}
+
+	csvWriter.Flush()
+
+	if err := csvWriter.Error(); err != nil {
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to flush CSV writer",
+		})
+	}
+
+	return c.Attachment("scores.csv", "scores.csv")
+}
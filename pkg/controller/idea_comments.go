@@ -0,0 +1,230 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"html"
+	"net/http"
+	"strings"
+
+	"github.com/CodeChefVIT/devsoc-be-24/pkg/db"
+	"github.com/CodeChefVIT/devsoc-be-24/pkg/dto"
+	logger "github.com/CodeChefVIT/devsoc-be-24/pkg/logging"
+	"github.com/CodeChefVIT/devsoc-be-24/pkg/models"
+	"github.com/CodeChefVIT/devsoc-be-24/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/labstack/echo/v4"
+)
+
+func isStaff(user db.User) bool {
+	return user.Role == "admin" || user.Role == "panel"
+}
+
+func ideaComments(rows []db.GetIdeaCommentsRow) []dto.IdeaComment {
+	comments := make([]dto.IdeaComment, 0, len(rows))
+	for _, row := range rows {
+		comments = append(comments, dto.IdeaComment{
+			ID:         row.ID.String(),
+			Body:       row.Body,
+			Internal:   row.Internal,
+			Author:     strings.TrimSpace(getSafeString(row.FirstName) + " " + getSafeString(row.LastName)),
+			AuthorRole: getSafeString(row.Role),
+			CreatedAt:  row.CreatedAt.Time,
+		})
+	}
+	return comments
+}
+
+// notifyIdeaComment emails staff already on the thread, and the team as well
+// when the comment is visible to them. Internal notes never reach the team.
+// It runs after the response is sent, so it takes its own context rather than
+// the request's.
+func notifyIdeaComment(ctx context.Context, author db.User, idea db.Idea, comment db.IdeaComment) {
+	recipients, err := utils.Queries.GetIdeaCommentStaffEmails(ctx, db.GetIdeaCommentStaffEmailsParams{
+		IdeaID:   idea.ID,
+		AuthorID: author.ID,
+	})
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+	}
+
+	if !comment.Internal && isStaff(author) {
+		emails, err := utils.Queries.GetTeamUsersEmails(ctx, uuid.NullUUID{UUID: idea.TeamID, Valid: true})
+		if err != nil {
+			logger.Errorf(logger.DatabaseError, err.Error())
+		}
+		recipients = append(recipients, emails...)
+	}
+
+	if len(recipients) == 0 {
+		return
+	}
+
+	utils.SendBulkEmail(recipients, "New comment on "+idea.Title,
+		fmt.Sprintf("<strong>%s %s</strong> commented on the idea <strong>%s</strong>:<br><br>%s",
+			html.EscapeString(author.FirstName), html.EscapeString(author.LastName),
+			html.EscapeString(idea.Title), html.EscapeString(comment.Body)))
+}
+
+func listIdeaComments(c echo.Context, idea db.Idea, includeInternal bool) error {
+	rows, err := utils.Queries.GetIdeaComments(c.Request().Context(), db.GetIdeaCommentsParams{
+		IdeaID:          idea.ID,
+		IncludeInternal: includeInternal,
+	})
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to fetch comments",
+		})
+	}
+
+	return c.JSON(http.StatusOK, &models.Response{
+		Status:  "success",
+		Message: "Comments fetched successfully",
+		Data:    ideaComments(rows),
+	})
+}
+
+func addIdeaComment(c echo.Context, idea db.Idea) error {
+	var payload models.IdeaComment
+
+	if err := c.Bind(&payload); err != nil {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: "Invalid request body",
+		})
+	}
+
+	if err := utils.Validate.Struct(payload); err != nil {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: utils.FormatValidationErrors(err),
+		})
+	}
+
+	user := c.Get("user").(db.User)
+	if !isStaff(user) {
+		payload.Internal = false
+	}
+
+	id, _ := uuid.NewV7()
+	comment, err := utils.Queries.CreateIdeaComment(c.Request().Context(), db.CreateIdeaCommentParams{
+		ID:       id,
+		IdeaID:   idea.ID,
+		AuthorID: uuid.NullUUID{UUID: user.ID, Valid: true},
+		Body:     strings.TrimSpace(payload.Body),
+		Internal: payload.Internal,
+	})
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to add comment",
+		})
+	}
+
+	go notifyIdeaComment(context.Background(), user, idea, comment)
+
+	return c.JSON(http.StatusCreated, &models.Response{
+		Status:  "success",
+		Message: "Comment added successfully",
+		Data:    comment,
+	})
+}
+
+// teamIdea loads the idea of the requesting user's team, writing the error
+// response itself when it cannot.
+func teamIdea(c echo.Context) (db.Idea, bool, error) {
+	user, ok := c.Get("user").(db.User)
+	if !ok || !user.TeamID.Valid {
+		return db.Idea{}, false, c.JSON(http.StatusForbidden, &models.Response{
+			Status:  "fail",
+			Message: "Please join a team or create one",
+		})
+	}
+
+	ctx := c.Request().Context()
+	row, err := utils.Queries.GetIdeaByTeamID(ctx, user.TeamID.UUID)
+	if err == nil {
+		var idea db.Idea
+		idea, err = utils.Queries.GetIdea(ctx, row.ID)
+		if err == nil {
+			return idea, true, nil
+		}
+	}
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		return db.Idea{}, false, c.JSON(http.StatusNotFound, &models.Response{
+			Status:  "fail",
+			Message: "Idea not found",
+		})
+	}
+	logger.Errorf(logger.DatabaseError, err.Error())
+	return db.Idea{}, false, c.JSON(http.StatusInternalServerError, &models.Response{
+		Status:  "fail",
+		Message: "Failed to fetch idea",
+	})
+}
+
+// staffIdea loads the idea named by the :id path parameter, writing the error
+// response itself when it cannot.
+func staffIdea(c echo.Context) (db.Idea, bool, error) {
+	ideaId, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return db.Idea{}, false, c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: "Invalid idea ID format",
+		})
+	}
+
+	idea, err := utils.Queries.GetIdea(c.Request().Context(), ideaId)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return db.Idea{}, false, c.JSON(http.StatusNotFound, &models.Response{
+				Status:  "fail",
+				Message: "Idea not found",
+			})
+		}
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return db.Idea{}, false, c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to fetch idea",
+		})
+	}
+	return idea, true, nil
+}
+
+func GetTeamIdeaComments(c echo.Context) error {
+	idea, ok, err := teamIdea(c)
+	if !ok {
+		return err
+	}
+	return listIdeaComments(c, idea, false)
+}
+
+func AddTeamIdeaComment(c echo.Context) error {
+	idea, ok, err := teamIdea(c)
+	if !ok {
+		return err
+	}
+	return addIdeaComment(c, idea)
+}
+
+func GetIdeaComments(c echo.Context) error {
+	idea, ok, err := staffIdea(c)
+	if !ok {
+		return err
+	}
+	return listIdeaComments(c, idea, true)
+}
+
+func AddIdeaComment(c echo.Context) error {
+	idea, ok, err := staffIdea(c)
+	if !ok {
+		return err
+	}
+	return addIdeaComment(c, idea)
+}
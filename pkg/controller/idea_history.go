@@ -0,0 +1,145 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/CodeChefVIT/devsoc-be-24/pkg/db"
+	"github.com/CodeChefVIT/devsoc-be-24/pkg/dto"
+	logger "github.com/CodeChefVIT/devsoc-be-24/pkg/logging"
+	"github.com/CodeChefVIT/devsoc-be-24/pkg/models"
+	"github.com/CodeChefVIT/devsoc-be-24/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/labstack/echo/v4"
+)
+
+func recordIdeaRevision(ctx context.Context, q *db.Queries, ideaId uuid.UUID, author db.User, title, description, track string) error {
+	id, _ := uuid.NewV7()
+	return q.CreateIdeaRevision(ctx, db.CreateIdeaRevisionParams{
+		ID:          id,
+		IdeaID:      ideaId,
+		Title:       title,
+		Description: description,
+		Track:       track,
+		AuthorID:    uuid.NullUUID{UUID: author.ID, Valid: true},
+	})
+}
+
+// ideaRevisions converts revision rows to DTOs. With withChanges set, every
+// revision after the first carries a word diff against the one before it.
+func ideaRevisions(rows []db.GetIdeaRevisionsRow, withChanges bool) []dto.IdeaRevision {
+	revisions := make([]dto.IdeaRevision, 0, len(rows))
+	for i, row := range rows {
+		revision := dto.IdeaRevision{
+			Revision:    row.Revision,
+			Title:       row.Title,
+			Description: row.Description,
+			Track:       row.Track,
+			Author:      strings.TrimSpace(getSafeString(row.FirstName) + " " + getSafeString(row.LastName)),
+			AuthorEmail: getSafeString(row.Email),
+			CreatedAt:   row.CreatedAt.Time,
+		}
+
+		if withChanges && i > 0 {
+			prev := rows[i-1]
+			revision.Changes = &dto.IdeaDiff{
+				Title:       utils.DiffWords(prev.Title, row.Title),
+				Description: utils.DiffWords(prev.Description, row.Description),
+				Track:       utils.DiffWords(prev.Track, row.Track),
+			}
+		}
+
+		revisions = append(revisions, revision)
+	}
+	return revisions
+}
+
+func GetIdeaHistory(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	user, ok := c.Get("user").(db.User)
+	if !ok || !user.TeamID.Valid {
+		return c.JSON(http.StatusForbidden, &models.Response{
+			Status:  "fail",
+			Message: "Please join a team or create one",
+		})
+	}
+
+	idea, err := utils.Queries.GetIdeaByTeamID(ctx, user.TeamID.UUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return c.JSON(http.StatusNotFound, &models.Response{
+				Status:  "fail",
+				Message: "Idea not found",
+			})
+		}
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to fetch idea",
+		})
+	}
+
+	rows, err := utils.Queries.GetIdeaRevisions(ctx, idea.ID)
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to fetch idea history",
+		})
+	}
+
+	return c.JSON(http.StatusOK, &models.Response{
+		Status:  "success",
+		Message: "Idea history fetched successfully",
+		Data:    ideaRevisions(rows, false),
+	})
+}
+
+func GetIdeaHistoryAdmin(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	ideaId, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: "Invalid idea ID format",
+		})
+	}
+
+	idea, err := utils.Queries.GetIdea(ctx, ideaId)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return c.JSON(http.StatusNotFound, &models.Response{
+				Status:  "fail",
+				Message: "Idea not found",
+			})
+		}
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to fetch idea",
+		})
+	}
+
+	rows, err := utils.Queries.GetIdeaRevisions(ctx, idea.ID)
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to fetch idea history",
+		})
+	}
+
+	return c.JSON(http.StatusOK, &models.Response{
+		Status:  "success",
+		Message: "Idea history fetched successfully",
+		Data: map[string]interface{}{
+			"idea":      idea,
+			"revisions": ideaRevisions(rows, true),
+		},
+	})
+}
@@ -0,0 +1,185 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"net/http"
+
+	"github.com/CodeChefVIT/devsoc-be-24/pkg/db"
+	logger "github.com/CodeChefVIT/devsoc-be-24/pkg/logging"
+	"github.com/CodeChefVIT/devsoc-be-24/pkg/models"
+	"github.com/CodeChefVIT/devsoc-be-24/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+var ideaDecisionEmails = map[string]struct{ subject, body string }{
+	"shortlisted": {"Idea Shortlisted", "Your idea <strong>%s</strong> has been shortlisted for the next stage of review."},
+	"selected":    {"Idea Selected", "Congratulations! Your idea <strong>%s</strong> has been selected."},
+	"rejected":    {"Idea Not Selected", "Unfortunately, your idea <strong>%s</strong> was not selected."},
+}
+
+// notifyIdeaDecision mails the idea's team about the decision. It runs after
+// the response has been sent, so it does not use the request context.
+func notifyIdeaDecision(idea db.ReviewIdeasRow, status, note string) {
+	mail, ok := ideaDecisionEmails[status]
+	if !ok {
+		return
+	}
+
+	emails, err := utils.Queries.GetTeamUsersEmails(context.Background(), uuid.NullUUID{UUID: idea.TeamID, Valid: true})
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return
+	}
+
+	body := fmt.Sprintf(mail.body, html.EscapeString(idea.Title))
+	if note != "" {
+		body += fmt.Sprintf("<br><br>Reviewer note: %s", html.EscapeString(note))
+	}
+	utils.SendBulkEmail(emails, mail.subject, body)
+}
+
+// reviewIdeas moves every idea in ids to status in one transaction. Selecting
+// ideas is rolled back if it pushes any track past its selection quota.
+func reviewIdeas(c echo.Context, ids []uuid.UUID, status, note string) error {
+	ctx := c.Request().Context()
+	actor := c.Get("user").(db.User)
+
+	tx, err := utils.DB.Begin(ctx)
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to start transaction",
+		})
+	}
+	defer tx.Rollback(ctx)
+	qtx := utils.Queries.WithTx(tx)
+
+	ideas, err := qtx.ReviewIdeas(ctx, db.ReviewIdeasParams{
+		Status:     status,
+		ReviewNote: note,
+		ReviewedBy: uuid.NullUUID{UUID: actor.ID, Valid: true},
+		Ids:        ids,
+	})
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to review ideas",
+		})
+	}
+
+	if len(ideas) == 0 {
+		return c.JSON(http.StatusNotFound, &models.Response{
+			Status:  "fail",
+			Message: "No ideas found",
+		})
+	}
+
+	if status == "selected" {
+		// Only the tracks touched by this review are checked, so a track that
+		// was already over quota does not block selections elsewhere.
+		tracks := make([]string, 0, len(ideas))
+		for _, idea := range ideas {
+			tracks = append(tracks, idea.Track)
+		}
+
+		over, err := qtx.GetOverQuotaTracks(ctx, tracks)
+		if err != nil {
+			logger.Errorf(logger.DatabaseError, err.Error())
+			return c.JSON(http.StatusInternalServerError, &models.Response{
+				Status:  "fail",
+				Message: "Failed to check track quotas",
+			})
+		}
+		if len(over) > 0 {
+			return c.JSON(http.StatusBadRequest, &models.Response{
+				Status:  "fail",
+				Message: "Selection exceeds the quota of one or more tracks",
+				Data:    over,
+			})
+		}
+	}
+
+	for _, idea := range ideas {
+		if err := recordAudit(ctx, qtx, actor, "idea.review", idea.TeamID,
+			uuid.NullUUID{UUID: idea.ID, Valid: true}, status); err != nil {
+			logger.Errorf(logger.DatabaseError, err.Error())
+			return c.JSON(http.StatusInternalServerError, &models.Response{
+				Status:  "fail",
+				Message: "Failed to record audit log",
+			})
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to review ideas",
+		})
+	}
+
+	go func() {
+		for _, idea := range ideas {
+			notifyIdeaDecision(idea, status, note)
+		}
+	}()
+
+	return c.JSON(http.StatusOK, &models.Response{
+		Status:  "success",
+		Message: fmt.Sprintf("%d idea(s) marked %s", len(ideas), status),
+		Data:    ideas,
+	})
+}
+
+func ReviewIdea(c echo.Context) error {
+	ideaId, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: "Invalid idea ID format",
+		})
+	}
+
+	var payload models.ReviewIdea
+
+	if err := c.Bind(&payload); err != nil {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: "Invalid request body",
+		})
+	}
+
+	if err := utils.Validate.Struct(payload); err != nil {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: utils.FormatValidationErrors(err),
+		})
+	}
+
+	return reviewIdeas(c, []uuid.UUID{ideaId}, payload.Status, payload.Note)
+}
+
+func BulkReviewIdeas(c echo.Context) error {
+	var payload models.BulkReviewIdeas
+
+	if err := c.Bind(&payload); err != nil {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: "Invalid request body",
+		})
+	}
+
+	if err := utils.Validate.Struct(payload); err != nil {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: utils.FormatValidationErrors(err),
+		})
+	}
+
+	return reviewIdeas(c, payload.IdeaIDs, payload.Status, payload.Note)
+}
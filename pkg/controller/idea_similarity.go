@@ -0,0 +1,78 @@
+package controller
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/CodeChefVIT/devsoc-be-24/pkg/dto"
+	logger "github.com/CodeChefVIT/devsoc-be-24/pkg/logging"
+	"github.com/CodeChefVIT/devsoc-be-24/pkg/models"
+	"github.com/CodeChefVIT/devsoc-be-24/pkg/utils"
+	"github.com/labstack/echo/v4"
+)
+
+const defaultSimilarityThreshold = 0.5
+
+func GetDuplicateIdeas(c echo.Context) error {
+	threshold := defaultSimilarityThreshold
+	if param := c.QueryParam("threshold"); param != "" {
+		t, err := strconv.ParseFloat(param, 64)
+		if err != nil || t <= 0 || t > 1 {
+			return c.JSON(http.StatusBadRequest, &models.Response{
+				Status:  "fail",
+				Message: "threshold must be a number in (0, 1]",
+			})
+		}
+		threshold = t
+	}
+
+	ideas, err := utils.Queries.ListIdeas(c.Request().Context())
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to fetch ideas",
+		})
+	}
+
+	docs := make([]string, len(ideas))
+	for i, idea := range ideas {
+		docs[i] = idea.Title + "\n" + idea.Description
+	}
+
+	pairs := utils.SimilarDocuments(docs, threshold)
+	clusterOf := map[int]int{}
+	clusters := make([]dto.IdeaCluster, 0)
+	for _, members := range utils.ClusterPairs(pairs) {
+		cluster := dto.IdeaCluster{}
+		for _, m := range members {
+			clusterOf[m] = len(clusters)
+			cluster.Ideas = append(cluster.Ideas, dto.SimilarIdea{
+				IdeaID: ideas[m].ID.String(),
+				TeamID: ideas[m].TeamID.String(),
+				Title:  ideas[m].Title,
+				Track:  ideas[m].Track,
+			})
+		}
+		clusters = append(clusters, cluster)
+	}
+
+	for _, p := range pairs {
+		cluster := &clusters[clusterOf[p.A]]
+		cluster.Pairs = append(cluster.Pairs, dto.IdeaSimilarity{
+			A:     ideas[p.A].ID.String(),
+			B:     ideas[p.B].ID.String(),
+			Score: math.Round(p.Score*1000) / 1000,
+		})
+	}
+
+	return c.JSON(http.StatusOK, &models.Response{
+		Status:  "success",
+		Message: "Similar ideas fetched successfully",
+		Data: map[string]interface{}{
+			"threshold": threshold,
+			"clusters":  clusters,
+		},
+	})
+}
@@ -31,16 +31,29 @@ func CreateIdea(c echo.Context) error {
 		})
 	}
 
-	var input db.CreateIdeaParams
-	if err := c.Bind(&input); err != nil {
+	var req models.CreateIdeaRequest
+	if err := c.Bind(&req); err != nil {
 		return c.JSON(http.StatusBadRequest, &models.Response{
 			Status:  "fail",
 			Message: "invalid request body",
 		})
 	}
 
-	input.ID = uuid.New()
-	input.TeamID = user.TeamID.UUID
+	if err := utils.Validate.Struct(req); err != nil {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: utils.FormatValidationErrors(err),
+		})
+	}
+
+	input := db.CreateIdeaParams{
+		ID:          uuid.New(),
+		Title:       req.Title,
+		Description: req.Description,
+		Track:       req.Track,
+		TeamID:      user.TeamID.UUID,
+		IsSelected:  false,
+	}
 
 	_, err := utils.Queries.GetIdeaByTeamID(context.Background(), input.TeamID)
 	if err == nil {
@@ -50,7 +63,29 @@ func CreateIdea(c echo.Context) error {
 		})
 	}
 
-	_, err = utils.Queries.CreateIdea(context.Background(), input)
+	ctx := c.Request().Context()
+
+	track, err := resolveTrack(ctx, input.Track)
+	if err != nil {
+		return trackError(c, err)
+	}
+	if err := checkTrackCapacity(ctx, track); err != nil {
+		return trackError(c, err)
+	}
+	input.Track = track.Slug
+
+	tx, err := utils.DB.Begin(ctx)
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to create idea",
+		})
+	}
+	defer tx.Rollback(ctx)
+	qtx := utils.Queries.WithTx(tx)
+
+	idea, err := qtx.CreateIdea(ctx, input)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return c.JSON(http.StatusNotFound, &models.Response{
@@ -66,6 +101,22 @@ func CreateIdea(c echo.Context) error {
 		})
 	}
 
+	if err := recordIdeaRevision(ctx, qtx, idea.ID, user, idea.Title, idea.Description, idea.Track); err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to create idea",
+		})
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to create idea",
+		})
+	}
+
 	return c.JSON(http.StatusCreated, &models.Response{
 		Status:  "success",
 		Message: "Idea created successfully",
@@ -113,7 +164,44 @@ func UpdateIdea(c echo.Context) error {
 		})
 	}
 
-	err := utils.Queries.UpdateIdea(ctx, db.UpdateIdeaParams{
+	idea, err := utils.Queries.GetIdeaByTeamID(ctx, teamUuid)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return c.JSON(http.StatusNotFound, &models.Response{
+				Status:  "fail",
+				Message: "Idea not found",
+			})
+		}
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to update idea",
+		})
+	}
+
+	track, err := resolveTrack(ctx, req.Track)
+	if err != nil {
+		return trackError(c, err)
+	}
+	if track.Slug != idea.Track {
+		if err := checkTrackCapacity(ctx, track); err != nil {
+			return trackError(c, err)
+		}
+	}
+	req.Track = track.Slug
+
+	tx, err := utils.DB.Begin(ctx)
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to update idea",
+		})
+	}
+	defer tx.Rollback(ctx)
+	qtx := utils.Queries.WithTx(tx)
+
+	err = qtx.UpdateIdea(ctx, db.UpdateIdeaParams{
 		TeamID:      teamUuid,
 		Title:       req.Title,
 		Description: req.Description,
@@ -127,6 +215,22 @@ func UpdateIdea(c echo.Context) error {
 		})
 	}
 
+	if err := recordIdeaRevision(ctx, qtx, idea.ID, user, req.Title, req.Description, req.Track); err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to update idea",
+		})
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to update idea",
+		})
+	}
+
 	return c.JSON(http.StatusOK, &models.Response{
 		Status:  "success",
 		Message: "Idea updated successfully",
@@ -170,6 +274,8 @@ func GetIdea(c echo.Context) error {
 			Title:       ideas.Title,
 			Description: ideas.Description,
 			Track:       ideas.Track,
+			Status:      ideas.Status,
+			ReviewNote:  ideas.ReviewNote,
 		},
 	})
 }
@@ -0,0 +1,276 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/CodeChefVIT/devsoc-be-24/pkg/dto"
+	logger "github.com/CodeChefVIT/devsoc-be-24/pkg/logging"
+	"github.com/CodeChefVIT/devsoc-be-24/pkg/models"
+	"github.com/CodeChefVIT/devsoc-be-24/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	integrityReportTTL = 30 * time.Minute
+	// initialCommitsChecked is how many of the oldest commits are inspected
+	// for code dumped into the repository in one go.
+	initialCommitsChecked = 3
+	// integrityWorkers caps the teams whose reports are fetched from GitHub
+	// concurrently, to stay clear of its secondary rate limits.
+	integrityWorkers = 5
+)
+
+func integrityReport(ctx context.Context, teamId uuid.UUID, teamName, link string) (dto.IntegrityReport, error) {
+	report := dto.IntegrityReport{
+		TeamID:              teamId.String(),
+		TeamName:            teamName,
+		LargeInitialCommits: []dto.LargeCommit{},
+		OutsideContributors: []dto.RepoContributor{},
+		CheckedAt:           time.Now(),
+	}
+
+	owner, name, err := utils.ParseGithubRepo(link)
+	if err != nil {
+		report.Error = "github link is not a repository link"
+		return report, nil
+	}
+	report.Repository = owner + "/" + name
+
+	commits, err := utils.Github.ListCommits(ctx, owner, name, time.Time{}, time.Time{})
+	if err != nil {
+		if errors.Is(err, utils.ErrRepoNotFound) {
+			report.Error = "repository does not exist or is private"
+			return report, nil
+		}
+		return report, err
+	}
+
+	report.CommitCount = len(commits)
+	report.HistoryTruncated = len(commits) >= utils.GithubCommitLimit
+
+	start := utils.Config.HackathonStart
+	for _, commit := range commits {
+		date := commit.Date
+		if !start.IsZero() && date.Before(start) {
+			report.CommitsBeforeStart++
+		}
+		if report.FirstCommitAt == nil || date.Before(*report.FirstCommitAt) {
+			report.FirstCommitAt = &date
+		}
+	}
+
+	// A truncated listing stops at the newest commits, which leaves out the
+	// pre-hackathon history this report is after, so count it separately.
+	if report.HistoryTruncated && !start.IsZero() {
+		before, err := utils.Github.ListCommits(ctx, owner, name, time.Time{}, start)
+		if err != nil {
+			return report, err
+		}
+		report.CommitsBeforeStart = len(before)
+	}
+
+	// Commits come back newest first, so the initial ones are at the end.
+	// With truncated history the real initial commits were never fetched.
+	if !report.HistoryTruncated {
+		initial := commits[max(len(commits)-initialCommitsChecked, 0):]
+		for _, commit := range initial {
+			stats, err := utils.Github.GetCommitStats(ctx, owner, name, commit.SHA)
+			if err != nil {
+				return report, err
+			}
+			if stats.Additions >= utils.Config.LargeCommitLines {
+				report.LargeInitialCommits = append(report.LargeInitialCommits, dto.LargeCommit{
+					SHA:         commit.SHA,
+					Author:      commit.Author,
+					Additions:   stats.Additions,
+					Files:       stats.Files,
+					CommittedAt: commit.Date,
+				})
+			}
+		}
+	}
+
+	contributors, err := utils.Github.ListContributors(ctx, owner, name)
+	if err != nil {
+		return report, err
+	}
+
+	members, err := utils.Queries.GetTeamMembers(ctx, uuid.NullUUID{UUID: teamId, Valid: true})
+	if err != nil {
+		return report, err
+	}
+
+	logins := make(map[string]bool, len(members))
+	for _, member := range members {
+		if member.GithubProfile != nil {
+			logins[utils.GithubLogin(*member.GithubProfile)] = true
+		}
+	}
+
+	for _, contributor := range contributors {
+		if !logins[strings.ToLower(contributor.Login)] {
+			report.OutsideContributors = append(report.OutsideContributors, dto.RepoContributor{
+				Login:   contributor.Login,
+				Commits: contributor.Contributions,
+			})
+		}
+	}
+
+	report.Flagged = report.CommitsBeforeStart > 0 ||
+		len(report.LargeInitialCommits) > 0 ||
+		len(report.OutsideContributors) > 0
+
+	return report, nil
+}
+
+func cachedIntegrityReport(ctx context.Context, teamId uuid.UUID, teamName, link string, refresh bool) (dto.IntegrityReport, error) {
+	key := "integrity_report:" + teamId.String()
+
+	if !refresh {
+		if cached, err := utils.RedisClient.Get(ctx, key).Bytes(); err == nil {
+			var report dto.IntegrityReport
+			if json.Unmarshal(cached, &report) == nil {
+				return report, nil
+			}
+		}
+	}
+
+	report, err := integrityReport(ctx, teamId, teamName, link)
+	if err != nil {
+		return report, err
+	}
+
+	if data, err := json.Marshal(report); err == nil {
+		utils.RedisClient.Set(ctx, key, data, integrityReportTTL)
+	}
+
+	return report, nil
+}
+
+// integrityReports builds a report for every team with a submission, fetching
+// up to integrityWorkers reports from GitHub at once. Reports cached by
+// cachedIntegrityReport are reused unless refresh is set. GitHub failures for
+// one team are recorded on its report instead of aborting the run.
+func integrityReports(ctx context.Context, refresh bool) ([]dto.IntegrityReport, error) {
+	repos, err := utils.Queries.GetSubmittedRepos(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	reports := make([]dto.IntegrityReport, len(repos))
+	slots := make(chan struct{}, integrityWorkers)
+	var wg sync.WaitGroup
+
+	for i, repo := range repos {
+		wg.Add(1)
+		slots <- struct{}{}
+		go func() {
+			defer func() {
+				<-slots
+				wg.Done()
+			}()
+
+			report, err := cachedIntegrityReport(ctx, repo.TeamID, repo.Name, repo.GithubLink, refresh)
+			if err != nil {
+				logger.Errorf(logger.InternalError, err.Error())
+				report.Error = "failed to fetch repository from github"
+			}
+			reports[i] = report
+		}()
+	}
+	wg.Wait()
+
+	return reports, nil
+}
+
+func GetIntegrityReports(c echo.Context) error {
+	reports, err := integrityReports(c.Request().Context(), c.QueryParam("refresh") == "true")
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to fetch submissions",
+		})
+	}
+
+	if c.QueryParam("flagged") == "true" {
+		flagged := make([]dto.IntegrityReport, 0, len(reports))
+		for _, report := range reports {
+			if report.Flagged {
+				flagged = append(flagged, report)
+			}
+		}
+		reports = flagged
+	}
+
+	return c.JSON(http.StatusOK, &models.Response{
+		Status:  "success",
+		Message: "Integrity reports generated successfully",
+		Data:    reports,
+	})
+}
+
+func GetTeamIntegrityReport(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	teamId, err := uuid.Parse(c.Param("teamId"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: "Invalid team ID format",
+		})
+	}
+
+	team, err := utils.Queries.GetTeamByTeamId(ctx, teamId)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return c.JSON(http.StatusNotFound, &models.Response{
+				Status:  "fail",
+				Message: "Team not found",
+			})
+		}
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to fetch team",
+		})
+	}
+
+	submission, err := utils.Queries.GetSubmissionByTeamID(ctx, teamId)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return c.JSON(http.StatusNotFound, &models.Response{
+				Status:  "fail",
+				Message: "Submission not found",
+			})
+		}
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to fetch submission",
+		})
+	}
+
+	report, err := cachedIntegrityReport(ctx, teamId, team.Name, submission.GithubLink, c.QueryParam("refresh") == "true")
+	if err != nil {
+		logger.Errorf(logger.InternalError, err.Error())
+		return c.JSON(http.StatusBadGateway, &models.Response{
+			Status:  "fail",
+			Message: "Failed to fetch repository from github",
+		})
+	}
+
+	return c.JSON(http.StatusOK, &models.Response{
+		Status:  "success",
+		Message: "Integrity report generated successfully",
+		Data:    report,
+	})
+}
@@ -0,0 +1,249 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/CodeChefVIT/devsoc-be-24/pkg/db"
+	logger "github.com/CodeChefVIT/devsoc-be-24/pkg/logging"
+	"github.com/CodeChefVIT/devsoc-be-24/pkg/models"
+	"github.com/CodeChefVIT/devsoc-be-24/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/labstack/echo/v4"
+)
+
+// roundTransitions lists the states a judging round may move to from each
+// state. Rounds without a judging_rounds row are open.
+var roundTransitions = map[string][]string{
+	"open":      {"locked"},
+	"locked":    {"published", "open"},
+	"published": {"open"},
+}
+
+func roundState(ctx context.Context, round int32) (string, error) {
+	judging, err := utils.Queries.GetJudgingRound(ctx, round)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "open", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return judging.State, nil
+}
+
+// roundClosedError is returned when a score change loses the race against the
+// round being locked or published.
+type roundClosedError struct {
+	round int32
+	state string
+}
+
+func (e roundClosedError) Error() string {
+	return fmt.Sprintf("Scores for round %d are %s", e.round, e.state)
+}
+
+// lockOpenRound holds a share lock on the round's judging_rounds row for the
+// rest of q's transaction and fails with roundClosedError unless the round
+// is open, so the round cannot be locked while scores are being written. The
+// row is created with its defaults first so there is always one to lock.
+func lockOpenRound(ctx context.Context, q *db.Queries, round int32) error {
+	if err := q.EnsureJudgingRound(ctx, round); err != nil {
+		return err
+	}
+	state, err := q.LockJudgingRoundState(ctx, round)
+	if err != nil {
+		return err
+	}
+	if state != "open" {
+		return roundClosedError{round: round, state: state}
+	}
+	return nil
+}
+
+func ListJudgingRounds(c echo.Context) error {
+	rounds, err := utils.Queries.ListJudgingRounds(c.Request().Context())
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to fetch judging rounds",
+		})
+	}
+
+	return c.JSON(http.StatusOK, &models.Response{
+		Status:  "success",
+		Message: "Judging rounds fetched successfully",
+		Data:    rounds,
+	})
+}
+
+// UpsertJudgingRound sets how scores of a round are normalised across judges
+// before they are aggregated on the leaderboard.
+func UpsertJudgingRound(c echo.Context) error {
+	var payload models.JudgingRound
+
+	if err := c.Bind(&payload); err != nil {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: "Invalid request body",
+		})
+	}
+
+	if err := utils.Validate.Struct(payload); err != nil {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: utils.FormatValidationErrors(err),
+		})
+	}
+
+	state, err := roundState(c.Request().Context(), payload.Round)
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to fetch judging round",
+		})
+	}
+	if state != "open" {
+		return c.JSON(http.StatusConflict, &models.Response{
+			Status:  "fail",
+			Message: fmt.Sprintf("Round %d is %s, reopen it before changing normalisation", payload.Round, state),
+		})
+	}
+
+	round, err := utils.Queries.UpsertJudgingRound(c.Request().Context(), db.UpsertJudgingRoundParams{
+		Round:         payload.Round,
+		Normalisation: payload.Normalisation,
+	})
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to save judging round",
+		})
+	}
+
+	return c.JSON(http.StatusOK, &models.Response{
+		Status:  "success",
+		Message: "Judging round saved successfully",
+		Data:    round,
+	})
+}
+
+// SetJudgingRoundState moves a round through open -> locked -> published.
+// Locking freezes its scores and records the finalisation time; reopening a
+// locked or published round needs a reason.
+func SetJudgingRoundState(c echo.Context) error {
+	ctx := c.Request().Context()
+	var payload models.JudgingRoundState
+
+	if err := c.Bind(&payload); err != nil {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: "Invalid request body",
+		})
+	}
+
+	if err := utils.Validate.Struct(payload); err != nil {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: utils.FormatValidationErrors(err),
+		})
+	}
+
+	var reason *string
+	if payload.State == "open" {
+		payload.Reason = strings.TrimSpace(payload.Reason)
+		if payload.Reason == "" {
+			return c.JSON(http.StatusBadRequest, &models.Response{
+				Status:  "fail",
+				Message: "A reason is required to reopen a round",
+			})
+		}
+		reason = &payload.Reason
+	}
+
+	user := c.Get("user").(db.User)
+
+	tx, err := utils.DB.Begin(ctx)
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to start transaction",
+		})
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := utils.Queries.WithTx(tx)
+
+	// Concurrent transitions of the same round wait on this lock, so each one
+	// checks the state the previous one committed.
+	if err := qtx.EnsureJudgingRound(ctx, payload.Round); err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to fetch judging round",
+		})
+	}
+	current, err := qtx.LockJudgingRoundForUpdate(ctx, payload.Round)
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to fetch judging round",
+		})
+	}
+
+	if !slices.Contains(roundTransitions[current], payload.State) {
+		return c.JSON(http.StatusConflict, &models.Response{
+			Status:  "fail",
+			Message: fmt.Sprintf("Round %d cannot move from %s to %s", payload.Round, current, payload.State),
+		})
+	}
+
+	round, err := qtx.SetJudgingRoundState(ctx, db.SetJudgingRoundStateParams{
+		Round:          payload.Round,
+		State:          payload.State,
+		ReopenReason:   reason,
+		StateChangedBy: uuid.NullUUID{UUID: user.ID, Valid: true},
+	})
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to update judging round",
+		})
+	}
+
+	details := fmt.Sprintf("round %d: %s -> %s", payload.Round, current, payload.State)
+	if payload.Reason != "" {
+		details += ": " + payload.Reason
+	}
+	if err := recordAudit(ctx, qtx, user, "judging.round."+payload.State, uuid.Nil, uuid.NullUUID{}, details); err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to record audit log",
+		})
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to update judging round",
+		})
+	}
+
+	return c.JSON(http.StatusOK, &models.Response{
+		Status:  "success",
+		Message: fmt.Sprintf("Round %d is now %s", round.Round, round.State),
+		Data:    round,
+	})
+}
@@ -0,0 +1,222 @@
+package controller
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/CodeChefVIT/devsoc-be-24/pkg/db"
+	logger "github.com/CodeChefVIT/devsoc-be-24/pkg/logging"
+	"github.com/CodeChefVIT/devsoc-be-24/pkg/models"
+	"github.com/CodeChefVIT/devsoc-be-24/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// leaderboardCursor is the sort key of the last team on a page. Teams are
+// ordered by normalised total, then the latest round's total, then the
+// innovation score, then team ID, so the key is unique and pages never
+// skip or repeat a team.
+type leaderboardCursor struct {
+	Total      float64   `json:"total"`
+	Latest     float64   `json:"latest"`
+	Innovation float64   `json:"innovation"`
+	TeamID     uuid.UUID `json:"team_id"`
+}
+
+func encodeLeaderboardCursor(row db.GetLeaderboardRow) string {
+	raw, _ := json.Marshal(leaderboardCursor{
+		Total:      row.OverallNormalised,
+		Latest:     row.LatestRoundTotal,
+		Innovation: row.Innovation,
+		TeamID:     row.TeamID,
+	})
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func decodeLeaderboardCursor(cursor string) (leaderboardCursor, error) {
+	var decoded leaderboardCursor
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return decoded, err
+	}
+	err = json.Unmarshal(raw, &decoded)
+	return decoded, err
+}
+
+// GetLeaderBoard ranks teams by their normalised total. The round, track and
+// qualified filters narrow the set of teams before ranking, so each filter
+// yields its own ranking (e.g. track winners); the name filter only narrows
+// the page and keeps overall ranks.
+func GetLeaderBoard(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	aggregate := c.QueryParam("aggregate")
+	if aggregate == "" {
+		aggregate = "mean"
+	}
+	if aggregate != "mean" && aggregate != "median" {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: "aggregate must be one of: mean median",
+		})
+	}
+
+	limit := 10
+	if parsedLimit, err := strconv.Atoi(c.QueryParam("limit")); err == nil && parsedLimit > 0 {
+		limit = min(parsedLimit, 100)
+	}
+
+	params := db.GetLeaderboardParams{
+		Aggregate: aggregate,
+		Track:     utils.TrackSlug(c.QueryParam("track")),
+		Name:      c.QueryParam("name"),
+		RowLimit:  int32(limit + 1),
+	}
+
+	if param := c.QueryParam("round"); param != "" {
+		round, ok := parseRound(param)
+		if !ok {
+			return c.JSON(http.StatusBadRequest, &models.Response{
+				Status:  "fail",
+				Message: "Invalid round",
+			})
+		}
+		params.Round = round
+	}
+
+	if param := c.QueryParam("qualified"); param != "" {
+		round, ok := parseRound(param)
+		if !ok {
+			return c.JSON(http.StatusBadRequest, &models.Response{
+				Status:  "fail",
+				Message: "Invalid qualified round",
+			})
+		}
+		params.Qualified = round
+	}
+
+	if cursorParam := c.QueryParam("cursor"); cursorParam != "" {
+		cursor, err := decodeLeaderboardCursor(cursorParam)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, &models.Response{
+				Status:  "fail",
+				Message: "Invalid cursor",
+			})
+		}
+		params.HasCursor = true
+		params.CursorTotal = cursor.Total
+		params.CursorLatest = cursor.Latest
+		params.CursorInnovation = cursor.Innovation
+		params.CursorTeam = cursor.TeamID
+	}
+
+	rows, err := utils.Queries.GetLeaderboard(ctx, params)
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to fetch leaderboard",
+		})
+	}
+
+	var nextCursor string
+	if len(rows) > limit {
+		rows = rows[:limit]
+		nextCursor = encodeLeaderboardCursor(rows[limit-1])
+	}
+
+	teamIds := make([]uuid.UUID, 0, len(rows))
+	for _, row := range rows {
+		teamIds = append(teamIds, row.TeamID)
+	}
+
+	rounds, err := utils.Queries.GetLeaderboardRounds(ctx, db.GetLeaderboardRoundsParams{
+		Aggregate: aggregate,
+		TeamIds:   teamIds,
+		Round:     params.Round,
+	})
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to fetch round scores",
+		})
+	}
+
+	criteria, err := utils.Queries.GetLeaderboardCriteria(ctx, db.GetLeaderboardCriteriaParams{
+		Aggregate: aggregate,
+		TeamIds:   teamIds,
+		Round:     params.Round,
+	})
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to fetch score breakdown",
+		})
+	}
+
+	type teamRound struct {
+		team  uuid.UUID
+		round int32
+	}
+	breakdown := make(map[teamRound]map[string]float64)
+	for _, row := range criteria {
+		key := teamRound{row.TeamID, row.Round}
+		if breakdown[key] == nil {
+			breakdown[key] = make(map[string]float64)
+		}
+		breakdown[key][row.Slug] = row.Points
+	}
+
+	teamRounds := make(map[uuid.UUID][]models.Round, len(rows))
+	for _, row := range rounds {
+		var finalisedAt *time.Time
+		if row.FinalisedAt.Valid {
+			finalisedAt = &row.FinalisedAt.Time
+		}
+
+		teamRounds[row.TeamID] = append(teamRounds[row.TeamID], models.Round{
+			Round:           int(row.Round),
+			Criteria:        breakdown[teamRound{row.TeamID, row.Round}],
+			RoundTotal:      row.RoundTotal,
+			NormalisedTotal: row.NormalisedTotal,
+			Normalisation:   row.Normalisation,
+			Judges:          int(row.Judges),
+			State:           row.State,
+			FinalisedAt:     finalisedAt,
+		})
+	}
+
+	leaderBoard := make([]models.TeamLeaderboard, 0, len(rows))
+	for _, row := range rows {
+		leaderBoard = append(leaderBoard, models.TeamLeaderboard{
+			Rank:              int(row.CompetitionRank),
+			DenseRank:         int(row.DenseRank),
+			TeamID:            row.TeamID,
+			TeamName:          row.Name,
+			Track:             row.Track,
+			Rounds:            teamRounds[row.TeamID],
+			OverallTotal:      row.OverallTotal,
+			OverallNormalised: row.OverallNormalised,
+			LatestRoundTotal:  row.LatestRoundTotal,
+			Innovation:        row.Innovation,
+		})
+	}
+
+	return c.JSON(http.StatusOK, &models.Response{
+		Status:  "success",
+		Message: "Leaderboard fetched successfully",
+		Data: map[string]interface{}{
+			"leaderboard": leaderBoard,
+			"aggregate":   aggregate,
+			"round":       params.Round,
+			"track":       params.Track,
+			"qualified":   params.Qualified,
+			"next_cursor": nextCursor,
+		},
+	})
+}
@@ -0,0 +1,256 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"html"
+	"net/http"
+	"strings"
+
+	"github.com/CodeChefVIT/devsoc-be-24/pkg/db"
+	logger "github.com/CodeChefVIT/devsoc-be-24/pkg/logging"
+	"github.com/CodeChefVIT/devsoc-be-24/pkg/models"
+	"github.com/CodeChefVIT/devsoc-be-24/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/labstack/echo/v4"
+)
+
+// flagTeamName queues a team name for admin review. The name is already live,
+// so a failure here is only logged.
+func flagTeamName(ctx context.Context, teamId uuid.UUID, name, reason string) {
+	id, _ := uuid.NewV7()
+	if err := utils.Queries.CreateTeamNameFlag(ctx, db.CreateTeamNameFlagParams{
+		ID:     id,
+		TeamID: teamId,
+		Name:   name,
+		Reason: reason,
+	}); err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+	}
+}
+
+func GetFlaggedTeamNames(c echo.Context) error {
+	flags, err := utils.Queries.GetPendingTeamNameFlags(c.Request().Context())
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to fetch flagged team names",
+		})
+	}
+
+	return c.JSON(http.StatusOK, &models.Response{
+		Status:  "success",
+		Message: "Flagged team names fetched successfully",
+		Data:    flags,
+	})
+}
+
+func ApproveTeamName(c echo.Context) error {
+	ctx := c.Request().Context()
+	actor := c.Get("user").(db.User)
+
+	flagId, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: "Invalid flag ID format",
+		})
+	}
+
+	flag, err := utils.Queries.GetTeamNameFlag(ctx, flagId)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return c.JSON(http.StatusNotFound, &models.Response{
+				Status:  "fail",
+				Message: "Flag not found",
+			})
+		}
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to fetch flag",
+		})
+	}
+
+	if flag.Status != "pending" {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: "Flag has already been reviewed",
+		})
+	}
+
+	tx, err := utils.DB.Begin(ctx)
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to start transaction",
+		})
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := utils.Queries.WithTx(tx)
+
+	if err := qtx.ResolveTeamNameFlag(ctx, db.ResolveTeamNameFlagParams{
+		ID:         flag.ID,
+		Status:     "approved",
+		ReviewedBy: uuid.NullUUID{UUID: actor.ID, Valid: true},
+	}); err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to approve team name",
+		})
+	}
+
+	if err := recordAudit(ctx, qtx, actor, "team.name.approve", flag.TeamID, uuid.NullUUID{UUID: flag.ID, Valid: true},
+		fmt.Sprintf("approved %s (%s)", flag.Name, flag.Reason)); err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to record audit log",
+		})
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to approve team name",
+		})
+	}
+
+	return c.JSON(http.StatusOK, &models.Response{
+		Status:  "success",
+		Message: "Team name approved",
+	})
+}
+
+func AdminRenameTeam(c echo.Context) error {
+	var payload models.AdminRenameTeam
+
+	if err := c.Bind(&payload); err != nil {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: "Invalid request body",
+		})
+	}
+
+	payload.Name = strings.TrimSpace(payload.Name)
+
+	if err := utils.Validate.Struct(payload); err != nil {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: utils.FormatValidationErrors(err),
+		})
+	}
+
+	if utils.NormalizeTeamName(payload.Name) == "" {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: "Team name must contain letters or digits",
+		})
+	}
+
+	// Admins may pick a name that would only be flagged for review, but not
+	// one that is blocked or reserved outright.
+	if verdict, reason := utils.CheckTeamName(payload.Name); verdict == utils.TeamNameRejected {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: "Team name " + reason,
+		})
+	}
+
+	ctx := c.Request().Context()
+	actor := c.Get("user").(db.User)
+
+	team, err := utils.Queries.GetTeamByTeamId(ctx, payload.TeamID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return c.JSON(http.StatusNotFound, &models.Response{
+				Status:  "fail",
+				Message: "Team not found",
+			})
+		}
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to fetch team",
+		})
+	}
+
+	tx, err := utils.DB.Begin(ctx)
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to start transaction",
+		})
+	}
+	defer tx.Rollback(ctx)
+	qtx := utils.Queries.WithTx(tx)
+
+	if err := qtx.UpdateTeamName(ctx, db.UpdateTeamNameParams{
+		Name: payload.Name,
+		ID:   team.ID,
+	}); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return c.JSON(http.StatusBadRequest, &models.Response{
+				Status:  "fail",
+				Message: "Team name has already been taken",
+			})
+		}
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to rename team",
+		})
+	}
+
+	if err := qtx.ResolveTeamNameFlagsByTeam(ctx, db.ResolveTeamNameFlagsByTeamParams{
+		TeamID:     team.ID,
+		Status:     "renamed",
+		ReviewedBy: uuid.NullUUID{UUID: actor.ID, Valid: true},
+	}); err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to resolve flagged names",
+		})
+	}
+
+	if err := recordAudit(ctx, qtx, actor, "team.rename", team.ID, uuid.NullUUID{},
+		fmt.Sprintf("renamed %s to %s", team.Name, payload.Name)); err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to record audit log",
+		})
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to rename team",
+		})
+	}
+
+	emails, err := utils.Queries.GetTeamUsersEmails(ctx, uuid.NullUUID{UUID: team.ID, Valid: true})
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+	}
+	go utils.SendBulkEmail(emails, "Team Renamed",
+		fmt.Sprintf("The organisers have renamed your team from <strong>%s</strong> to <strong>%s</strong>.",
+			html.EscapeString(team.Name), html.EscapeString(payload.Name)))
+
+	return c.JSON(http.StatusOK, &models.Response{
+		Status:  "success",
+		Message: "Team renamed successfully",
+	})
+}
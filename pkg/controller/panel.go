@@ -1,14 +1,17 @@
 package controller
 
 import (
+	"errors"
+	"fmt"
 	"net/http"
 
 	"github.com/CodeChefVIT/devsoc-be-24/pkg/db"
-	"github.com/CodeChefVIT/devsoc-be-24/pkg/dto"
 	logger "github.com/CodeChefVIT/devsoc-be-24/pkg/logging"
 	"github.com/CodeChefVIT/devsoc-be-24/pkg/models"
 	"github.com/CodeChefVIT/devsoc-be-24/pkg/utils"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/labstack/echo/v4"
 )
 
@@ -24,23 +27,40 @@ func GetSubmission(c echo.Context) error {
 			Message: "Invalid team ID format"})
 	}
 
-	submission, err := utils.Queries.GetSubmissionByTeamID(ctx, teamUuid)
+	team, err := utils.Queries.GetTeamByTeamId(ctx, teamUuid)
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return c.JSON(http.StatusNotFound, &models.Response{
+				Status:  "fail",
+				Message: "Team not found"})
+		}
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to fetch team"})
+	}
+
+	round, ok := submissionRound(c, team)
+	if !ok {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: "Invalid round"})
+	}
+
+	submission, err := frozenSubmission(ctx, team.ID, round)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return c.JSON(http.StatusNotFound, &models.Response{
+				Status:  "fail",
+				Message: "Submission not found"})
+		}
 		logger.Errorf(logger.InternalError, err.Error())
 		return c.JSON(http.StatusBadRequest, &models.Response{
 			Status:  "fail",
 			Message: err.Error()})
 	}
 
-	return c.JSON(http.StatusOK, dto.Submission{
-		Title:       submission.Title,
-		Description: submission.Description,
-		GithubLink:  submission.GithubLink,
-		FigmaLink:   submission.FigmaLink,
-		OtherLink:   submission.OtherLink,
-		TeamID:      submission.TeamID.String(),
-	},
-	)
+	return c.JSON(http.StatusOK, submission)
 }
 
 func UpdateScore(c echo.Context) error {
@@ -80,20 +100,123 @@ func UpdateScore(c echo.Context) error {
 		)
 	}
 
+	existing, err := utils.Queries.GetScoreByID(ctx, scoreid)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return c.JSON(http.StatusNotFound, &models.Response{
+				Status:  "fail",
+				Message: "Score not found"},
+			)
+		}
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to fetch score"},
+		)
+	}
+
+	user := c.Get("user").(db.User)
+	if !ownsScore(user, existing) {
+		return c.JSON(http.StatusForbidden, &models.Response{
+			Status:  "fail",
+			Message: "You can only change scores you entered"},
+		)
+	}
+
+	for _, target := range []db.Score{existing, {TeamID: teamid, Round: int32(points.Round)}} {
+		state, err := roundState(ctx, target.Round)
+		if err != nil {
+			logger.Errorf(logger.DatabaseError, err.Error())
+			return c.JSON(http.StatusInternalServerError, &models.Response{
+				Status:  "fail",
+				Message: "Failed to fetch judging round"},
+			)
+		}
+		if state != "open" {
+			return c.JSON(http.StatusForbidden, &models.Response{
+				Status:  "fail",
+				Message: fmt.Sprintf("Scores for round %d are %s", target.Round, state)},
+			)
+		}
+
+		allowed, err := canScoreTeam(ctx, user, target.TeamID, target.Round)
+		if err != nil {
+			logger.Errorf(logger.DatabaseError, err.Error())
+			return c.JSON(http.StatusInternalServerError, &models.Response{
+				Status:  "fail",
+				Message: "Failed to check panel assignment"},
+			)
+		}
+		if !allowed {
+			return c.JSON(http.StatusForbidden, &models.Response{
+				Status:  "fail",
+				Message: "You are not assigned to score this team"},
+			)
+		}
+	}
+
+	conflicts, err := teamConflicts(ctx, user.ID, teamid)
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to check conflicts of interest"},
+		)
+	}
+	if len(conflicts) > 0 {
+		return c.JSON(http.StatusForbidden, &models.Response{
+			Status:  "fail",
+			Message: "You have a conflict of interest with this team (" + conflictReasons(conflicts) + ")"},
+		)
+	}
+
+	criteria, err := utils.Queries.GetRubricCriteria(ctx, int32(points.Round))
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to fetch rubric"},
+		)
+	}
+
+	if err := checkScorecard(criteria, int32(points.Round), points.Scores); err != nil {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: err.Error()},
+		)
+	}
+
 	score := db.UpdateScoreParams{
-		ID:             scoreid,
-		TeamID:         teamid,
-		Design:         int32(points.Design),
-		Implementation: int32(points.Implementation),
-		Presentation:   int32(points.Presentation),
-		Teamwork:       int32(points.Teamwork),
-		Innovation:     int32(points.Innovation),
-		Comment:        &points.Comment,
-		Round:          int32(points.Round),
+		ID:      scoreid,
+		TeamID:  teamid,
+		Comment: &points.Comment,
+		Round:   int32(points.Round),
 	}
 
-	err = utils.Queries.UpdateScore(ctx, score)
+	audited := db.Score{ID: scoreid, TeamID: teamid, Round: int32(points.Round), PanelID: existing.PanelID}
+	err = saveScorecard(ctx, user, "update", points.Reason, audited, criteria, points.Scores, func(q *db.Queries) error {
+		if existing.Round != score.Round {
+			if err := lockOpenRound(ctx, q, existing.Round); err != nil {
+				return err
+			}
+		}
+		return q.UpdateScore(ctx, score)
+	})
 	if err != nil {
+		var closed roundClosedError
+		if errors.As(err, &closed) {
+			return c.JSON(http.StatusForbidden, &models.Response{
+				Status:  "fail",
+				Message: closed.Error()},
+			)
+		}
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return c.JSON(http.StatusConflict, &models.Response{
+				Status:  "fail",
+				Message: "This judge has already scored that team for that round"},
+			)
+		}
 		logger.Errorf(logger.DatabaseError, err.Error())
 		return c.JSON(http.StatusBadRequest, &models.Response{
 			Status:  "fail",
@@ -120,7 +243,99 @@ func DeleteScore(c echo.Context) error {
 		)
 	}
 
-	err = utils.Queries.DeleteScore(ctx, scoreUuid)
+	reason := c.QueryParam("reason")
+	if len(reason) > 500 {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: "reason must be at most 500 characters"},
+		)
+	}
+
+	score, err := utils.Queries.GetScoreByID(ctx, scoreUuid)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return c.JSON(http.StatusNotFound, &models.Response{
+				Status:  "fail",
+				Message: "Score not found"},
+			)
+		}
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to fetch score"},
+		)
+	}
+
+	user := c.Get("user").(db.User)
+	if !ownsScore(user, score) {
+		return c.JSON(http.StatusForbidden, &models.Response{
+			Status:  "fail",
+			Message: "You can only change scores you entered"},
+		)
+	}
+
+	state, err := roundState(ctx, score.Round)
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to fetch judging round"},
+		)
+	}
+	if state != "open" {
+		return c.JSON(http.StatusForbidden, &models.Response{
+			Status:  "fail",
+			Message: fmt.Sprintf("Scores for round %d are %s", score.Round, state)},
+		)
+	}
+
+	allowed, err := canScoreTeam(ctx, user, score.TeamID, score.Round)
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to check panel assignment"},
+		)
+	}
+	if !allowed {
+		return c.JSON(http.StatusForbidden, &models.Response{
+			Status:  "fail",
+			Message: "You are not assigned to score this team"},
+		)
+	}
+
+	tx, err := utils.DB.Begin(ctx)
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to delete score"})
+	}
+	defer tx.Rollback(ctx)
+	qtx := utils.Queries.WithTx(tx)
+
+	if err := lockOpenRound(ctx, qtx, score.Round); err != nil {
+		var closed roundClosedError
+		if errors.As(err, &closed) {
+			return c.JSON(http.StatusForbidden, &models.Response{
+				Status:  "fail",
+				Message: closed.Error()},
+			)
+		}
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to fetch judging round"})
+	}
+
+	if err := setScoreAuditContext(ctx, qtx, user, reason); err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to record score change"})
+	}
+
+	err = qtx.DeleteScore(ctx, scoreUuid)
 	if err != nil {
 		logger.Errorf(logger.DatabaseError, err.Error())
 		return c.JSON(http.StatusBadRequest, &models.Response{
@@ -128,6 +343,13 @@ func DeleteScore(c echo.Context) error {
 			Message: err.Error()})
 	}
 
+	if err := tx.Commit(ctx); err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to delete score"})
+	}
+
 	return c.JSON(http.StatusOK, &models.Response{
 		Status: "success",
 		Data: map[string]string{
@@ -164,20 +386,95 @@ func CreateScore(c echo.Context) error {
 		)
 	}
 
+	state, err := roundState(ctx, int32(points.Round))
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to fetch judging round"},
+		)
+	}
+	if state != "open" {
+		return c.JSON(http.StatusForbidden, &models.Response{
+			Status:  "fail",
+			Message: fmt.Sprintf("Scores for round %d are %s", int32(points.Round), state)},
+		)
+	}
+
+	user := c.Get("user").(db.User)
+	allowed, err := canScoreTeam(ctx, user, teamid, int32(points.Round))
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to check panel assignment"},
+		)
+	}
+	if !allowed {
+		return c.JSON(http.StatusForbidden, &models.Response{
+			Status:  "fail",
+			Message: "You are not assigned to score this team"},
+		)
+	}
+
+	conflicts, err := teamConflicts(ctx, user.ID, teamid)
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to check conflicts of interest"},
+		)
+	}
+	if len(conflicts) > 0 {
+		return c.JSON(http.StatusForbidden, &models.Response{
+			Status:  "fail",
+			Message: "You have a conflict of interest with this team (" + conflictReasons(conflicts) + ")"},
+		)
+	}
+
+	criteria, err := utils.Queries.GetRubricCriteria(ctx, int32(points.Round))
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to fetch rubric"},
+		)
+	}
+
+	if err := checkScorecard(criteria, int32(points.Round), points.Scores); err != nil {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: err.Error()},
+		)
+	}
+
 	score := db.CreateScoreParams{
-		TeamID:         teamid,
-		Round:          int32(points.Round),
-		Presentation:   int32(points.Presentation),
-		Implementation: int32(points.Implementation),
-		Teamwork:       int32(points.Teamwork),
-		Comment:        &points.Comment,
-		Innovation:     int32(points.Innovation),
-		Design:         int32(points.Design),
+		TeamID:  teamid,
+		Round:   int32(points.Round),
+		Comment: &points.Comment,
+		PanelID: uuid.NullUUID{UUID: user.ID, Valid: true},
 	}
 	score.ID, _ = uuid.NewV7()
 
-	err = utils.Queries.CreateScore(ctx, score)
+	audited := db.Score{ID: score.ID, TeamID: score.TeamID, Round: score.Round, PanelID: score.PanelID}
+	err = saveScorecard(ctx, user, "create", "", audited, criteria, points.Scores, func(q *db.Queries) error {
+		return q.CreateScore(ctx, score)
+	})
 	if err != nil {
+		var closed roundClosedError
+		if errors.As(err, &closed) {
+			return c.JSON(http.StatusForbidden, &models.Response{
+				Status:  "fail",
+				Message: closed.Error()},
+			)
+		}
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return c.JSON(http.StatusConflict, &models.Response{
+				Status:  "fail",
+				Message: "You have already scored this team for this round, update your existing score instead"},
+			)
+		}
 		logger.Errorf(logger.DatabaseError, err.Error())
 		return c.JSON(http.StatusBadRequest, &models.Response{
 			Status:  "fail",
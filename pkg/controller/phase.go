@@ -0,0 +1,250 @@
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/CodeChefVIT/devsoc-be-24/pkg/db"
+	logger "github.com/CodeChefVIT/devsoc-be-24/pkg/logging"
+	"github.com/CodeChefVIT/devsoc-be-24/pkg/models"
+	"github.com/CodeChefVIT/devsoc-be-24/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/labstack/echo/v4"
+)
+
+func GetPhases(c echo.Context) error {
+	phases, err := utils.Queries.GetPhases(c.Request().Context())
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to fetch phases",
+		})
+	}
+
+	return c.JSON(http.StatusOK, &models.Response{
+		Status:  "success",
+		Message: "Phases fetched successfully",
+		Data:    phases,
+	})
+}
+
+func UpsertPhase(c echo.Context) error {
+	var payload models.UpsertPhase
+
+	if err := c.Bind(&payload); err != nil {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: "Invalid request body",
+		})
+	}
+
+	if err := utils.Validate.Struct(payload); err != nil {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: utils.FormatValidationErrors(err),
+		})
+	}
+
+	if !payload.ClosesAt.After(payload.OpensAt) {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: "closes_at must be after opens_at",
+		})
+	}
+
+	phase, err := utils.Queries.UpsertPhase(c.Request().Context(), db.UpsertPhaseParams{
+		Name:         payload.Name,
+		OpensAt:      pgtype.Timestamptz{Time: payload.OpensAt, Valid: true},
+		ClosesAt:     pgtype.Timestamptz{Time: payload.ClosesAt, Valid: true},
+		GraceMinutes: payload.GraceMinutes,
+	})
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to save phase",
+		})
+	}
+
+	return c.JSON(http.StatusOK, &models.Response{
+		Status:  "success",
+		Message: "Phase saved successfully",
+		Data:    phase,
+	})
+}
+
+func GetPhaseOverrides(c echo.Context) error {
+	overrides, err := utils.Queries.GetPhaseOverrides(c.Request().Context(), c.Param("phase"))
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to fetch overrides",
+		})
+	}
+
+	return c.JSON(http.StatusOK, &models.Response{
+		Status:  "success",
+		Message: "Overrides fetched successfully",
+		Data:    overrides,
+	})
+}
+
+func GrantPhaseOverride(c echo.Context) error {
+	var payload models.PhaseOverride
+
+	if err := c.Bind(&payload); err != nil {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: "Invalid request body",
+		})
+	}
+
+	if err := utils.Validate.Struct(payload); err != nil {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: utils.FormatValidationErrors(err),
+		})
+	}
+
+	if payload.Until.IsZero() {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: "until field is required",
+		})
+	}
+
+	ctx := c.Request().Context()
+	actor := c.Get("user").(db.User)
+
+	if _, err := utils.Queries.GetPhase(ctx, payload.Phase); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return c.JSON(http.StatusNotFound, &models.Response{
+				Status:  "fail",
+				Message: "Phase not found",
+			})
+		}
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to fetch phase",
+		})
+	}
+
+	tx, err := utils.DB.Begin(ctx)
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to start transaction",
+		})
+	}
+	defer tx.Rollback(ctx)
+	qtx := utils.Queries.WithTx(tx)
+
+	id, _ := uuid.NewV7()
+	if err := qtx.UpsertPhaseOverride(ctx, db.UpsertPhaseOverrideParams{
+		ID:        id,
+		Phase:     payload.Phase,
+		TeamID:    payload.TeamID,
+		ClosesAt:  pgtype.Timestamptz{Time: payload.Until, Valid: true},
+		GrantedBy: actor.ID,
+	}); err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: "Failed to grant override",
+		})
+	}
+
+	if err := recordAudit(ctx, qtx, actor, "phase.override.grant", payload.TeamID, uuid.NullUUID{},
+		fmt.Sprintf("%s open until %s", payload.Phase, payload.Until)); err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to record audit log",
+		})
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to grant override",
+		})
+	}
+
+	return c.JSON(http.StatusOK, &models.Response{
+		Status:  "success",
+		Message: "Override granted successfully",
+	})
+}
+
+func RevokePhaseOverride(c echo.Context) error {
+	var payload models.PhaseOverride
+
+	if err := c.Bind(&payload); err != nil {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: "Invalid request body",
+		})
+	}
+
+	if err := utils.Validate.Struct(payload); err != nil {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: utils.FormatValidationErrors(err),
+		})
+	}
+
+	ctx := c.Request().Context()
+	actor := c.Get("user").(db.User)
+
+	tx, err := utils.DB.Begin(ctx)
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to start transaction",
+		})
+	}
+	defer tx.Rollback(ctx)
+	qtx := utils.Queries.WithTx(tx)
+
+	if err := qtx.DeletePhaseOverride(ctx, db.DeletePhaseOverrideParams{
+		Phase:  payload.Phase,
+		TeamID: payload.TeamID,
+	}); err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to revoke override",
+		})
+	}
+
+	if err := recordAudit(ctx, qtx, actor, "phase.override.revoke", payload.TeamID, uuid.NullUUID{},
+		payload.Phase); err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to record audit log",
+		})
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to revoke override",
+		})
+	}
+
+	return c.JSON(http.StatusOK, &models.Response{
+		Status:  "success",
+		Message: "Override revoked successfully",
+	})
+}
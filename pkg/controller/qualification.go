@@ -0,0 +1,286 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"html"
+	"math"
+	"net/http"
+
+	"github.com/CodeChefVIT/devsoc-be-24/pkg/db"
+	"github.com/CodeChefVIT/devsoc-be-24/pkg/dto"
+	logger "github.com/CodeChefVIT/devsoc-be-24/pkg/logging"
+	"github.com/CodeChefVIT/devsoc-be-24/pkg/models"
+	"github.com/CodeChefVIT/devsoc-be-24/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/labstack/echo/v4"
+)
+
+// qualificationPlan splits the teams still in the round into those that
+// qualify for the next round and those that do not. Teams without scores for
+// the round never qualify. Qualifying never lowers a team's round and not
+// qualifying never raises it, so re-running a plan is safe.
+func qualificationPlan(ctx context.Context, payload models.QualifyTeams) ([]dto.QualificationEntry, []dto.QualificationEntry, error) {
+	pool, err := utils.Queries.GetQualificationPool(ctx, payload.Round)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rows, err := utils.Queries.GetLeaderboard(ctx, db.GetLeaderboardParams{
+		Aggregate: payload.Aggregate,
+		Round:     payload.Round,
+		Qualified: payload.Round,
+		RowLimit:  math.MaxInt32,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	current := make(map[uuid.UUID]int32, len(pool))
+	for _, team := range pool {
+		current[team.ID] = team.RoundQualified
+	}
+
+	qualified := []dto.QualificationEntry{}
+	rest := []dto.QualificationEntry{}
+	ranked := make(map[uuid.UUID]bool, len(rows))
+
+	// Ranks are counted over the pool only, so banned or otherwise excluded
+	// teams never take a slot. A team shares the rank of the team before it
+	// in its group only when the whole sort key ties, matching the
+	// leaderboard's competition rank. Mode top ranks everyone as one group.
+	groupPosition := make(map[string]int)
+	groupRank := make(map[string]int)
+	groupLast := make(map[string]db.GetLeaderboardRow)
+
+	for _, row := range rows {
+		from, ok := current[row.TeamID]
+		if !ok {
+			continue
+		}
+		ranked[row.TeamID] = true
+
+		group := ""
+		if payload.Mode == "track" {
+			group = row.Track
+		}
+		groupPosition[group]++
+		last, seen := groupLast[group]
+		if !seen || last.OverallNormalised != row.OverallNormalised ||
+			last.LatestRoundTotal != row.LatestRoundTotal || last.Innovation != row.Innovation {
+			groupRank[group] = groupPosition[group]
+		}
+		groupLast[group] = row
+
+		entry := dto.QualificationEntry{
+			TeamID:   row.TeamID.String(),
+			TeamName: row.Name,
+			Track:    row.Track,
+			Rank:     groupRank[group],
+			Total:    row.OverallNormalised,
+			Scored:   true,
+			From:     from,
+		}
+
+		selected := entry.Rank <= payload.TopN
+		if payload.Mode == "threshold" {
+			selected = row.OverallNormalised >= payload.Threshold
+		}
+
+		if selected {
+			entry.To = max(from, payload.Round+1)
+			qualified = append(qualified, entry)
+		} else {
+			entry.To = min(from, payload.Round)
+			rest = append(rest, entry)
+		}
+	}
+
+	for _, team := range pool {
+		if ranked[team.ID] {
+			continue
+		}
+		rest = append(rest, dto.QualificationEntry{
+			TeamID:   team.ID.String(),
+			TeamName: team.Name,
+			From:     team.RoundQualified,
+			To:       min(team.RoundQualified, payload.Round),
+		})
+	}
+
+	return qualified, rest, nil
+}
+
+func bindQualification(c echo.Context) (models.QualifyTeams, error) {
+	var payload models.QualifyTeams
+
+	if err := c.Bind(&payload); err != nil {
+		return payload, errors.New("Invalid request body")
+	}
+
+	if err := utils.Validate.Struct(payload); err != nil {
+		return payload, errors.New(utils.FormatValidationErrors(err))
+	}
+
+	if payload.Mode != "threshold" && payload.TopN < 1 {
+		return payload, fmt.Errorf("top_n must be at least 1 for mode %s", payload.Mode)
+	}
+
+	if payload.Aggregate == "" {
+		payload.Aggregate = "mean"
+	}
+	return payload, nil
+}
+
+// PreviewQualification shows which teams a qualification run would move to
+// the next round without changing anything.
+func PreviewQualification(c echo.Context) error {
+	payload, err := bindQualification(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: err.Error(),
+		})
+	}
+
+	qualified, rest, err := qualificationPlan(c.Request().Context(), payload)
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to rank teams",
+		})
+	}
+
+	return c.JSON(http.StatusOK, &models.Response{
+		Status:  "success",
+		Message: fmt.Sprintf("%d teams would qualify for round %d", len(qualified), payload.Round+1),
+		Data: map[string]interface{}{
+			"qualified":     qualified,
+			"not_qualified": rest,
+		},
+	})
+}
+
+// ApplyQualification updates round_qualified for every team in the plan in
+// one transaction and then emails each team its result. The round's scores
+// must be locked first so the ranking cannot shift underneath it.
+func ApplyQualification(c echo.Context) error {
+	ctx := c.Request().Context()
+	actor := c.Get("user").(db.User)
+
+	payload, err := bindQualification(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: err.Error(),
+		})
+	}
+
+	state, err := roundState(ctx, payload.Round)
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to fetch judging round",
+		})
+	}
+	if state == "open" {
+		return c.JSON(http.StatusConflict, &models.Response{
+			Status:  "fail",
+			Message: fmt.Sprintf("Lock round %d before applying qualification", payload.Round),
+		})
+	}
+
+	qualified, rest, err := qualificationPlan(ctx, payload)
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to rank teams",
+		})
+	}
+
+	tx, err := utils.DB.Begin(ctx)
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to start transaction",
+		})
+	}
+	defer tx.Rollback(ctx)
+	qtx := utils.Queries.WithTx(tx)
+
+	updated := 0
+	teamIds := make([]uuid.UUID, 0, len(qualified)+len(rest))
+	for _, entry := range append(append([]dto.QualificationEntry{}, qualified...), rest...) {
+		teamId := uuid.MustParse(entry.TeamID)
+		teamIds = append(teamIds, teamId)
+		if entry.From == entry.To {
+			continue
+		}
+
+		if err := qtx.UpdateTeamRound(ctx, db.UpdateTeamRoundParams{
+			RoundQualified: pgtype.Int4{Int32: entry.To, Valid: true},
+			ID:             teamId,
+		}); err != nil {
+			logger.Errorf(logger.DatabaseError, err.Error())
+			return c.JSON(http.StatusInternalServerError, &models.Response{
+				Status:  "fail",
+				Message: "Failed to update qualified rounds",
+			})
+		}
+
+		if err := recordAudit(ctx, qtx, actor, "team.qualify", teamId, uuid.NullUUID{},
+			fmt.Sprintf("round %d %s: %d -> %d", payload.Round, payload.Mode, entry.From, entry.To)); err != nil {
+			logger.Errorf(logger.DatabaseError, err.Error())
+			return c.JSON(http.StatusInternalServerError, &models.Response{
+				Status:  "fail",
+				Message: "Failed to record audit log",
+			})
+		}
+		updated++
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to update qualified rounds",
+		})
+	}
+
+	members, err := utils.Queries.GetTeamsUsersEmails(ctx, teamIds)
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+	}
+	emails := make(map[string][]string)
+	for _, member := range members {
+		emails[member.TeamID.UUID.String()] = append(emails[member.TeamID.UUID.String()], member.Email)
+	}
+
+	// Mailing every team in the round takes a while, so it runs after the
+	// response instead of holding the request open.
+	go func() {
+		for _, entry := range qualified {
+			utils.SendBulkEmail(emails[entry.TeamID], fmt.Sprintf("Qualified for Round %d", payload.Round+1),
+				fmt.Sprintf("Congratulations! Your team <strong>%s</strong> has qualified for round %d.", html.EscapeString(entry.TeamName), payload.Round+1))
+		}
+		for _, entry := range rest {
+			utils.SendBulkEmail(emails[entry.TeamID], fmt.Sprintf("Round %d Results", payload.Round),
+				fmt.Sprintf("Unfortunately, your team <strong>%s</strong> has not qualified for round %d. Thank you for participating.", html.EscapeString(entry.TeamName), payload.Round+1))
+		}
+	}()
+
+	return c.JSON(http.StatusOK, &models.Response{
+		Status:  "success",
+		Message: fmt.Sprintf("%d teams qualified for round %d, %d teams updated", len(qualified), payload.Round+1, updated),
+		Data: map[string]interface{}{
+			"qualified":     qualified,
+			"not_qualified": rest,
+		},
+	})
+}
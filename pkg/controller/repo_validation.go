@@ -0,0 +1,214 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/CodeChefVIT/devsoc-be-24/pkg/db"
+	"github.com/CodeChefVIT/devsoc-be-24/pkg/dto"
+	logger "github.com/CodeChefVIT/devsoc-be-24/pkg/logging"
+	"github.com/CodeChefVIT/devsoc-be-24/pkg/models"
+	"github.com/CodeChefVIT/devsoc-be-24/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/labstack/echo/v4"
+)
+
+// repoValidationTTL is how long a validation result is cached so repeated
+// views by judges do not burn through the GitHub rate limit.
+const repoValidationTTL = 10 * time.Minute
+
+func validateRepo(ctx context.Context, teamId uuid.UUID, link string) (dto.RepoValidation, error) {
+	result := dto.RepoValidation{
+		Link:           link,
+		Contributors:   []dto.RepoContributor{},
+		MissingMembers: []string{},
+		Issues:         []string{},
+		CheckedAt:      time.Now(),
+	}
+
+	start, end := utils.Config.HackathonStart, utils.Config.HackathonEnd
+	if !start.IsZero() {
+		result.WindowStart = &start
+	}
+	if !end.IsZero() {
+		result.WindowEnd = &end
+	}
+
+	owner, name, err := utils.ParseGithubRepo(link)
+	if err != nil {
+		result.Issues = append(result.Issues, "github link is not a repository link")
+		return result, nil
+	}
+	result.Repository = owner + "/" + name
+
+	repo, err := utils.Github.GetRepo(ctx, owner, name)
+	if err != nil {
+		if errors.Is(err, utils.ErrRepoNotFound) {
+			result.Issues = append(result.Issues, "repository does not exist or is private")
+			return result, nil
+		}
+		return result, err
+	}
+
+	result.Exists = true
+	result.Public = !repo.Private
+	result.Fork = repo.Fork
+	if repo.Private {
+		result.Issues = append(result.Issues, "repository is private")
+	}
+	if repo.Fork {
+		result.Issues = append(result.Issues, "repository is a fork")
+	}
+
+	commits, err := utils.Github.ListCommits(ctx, owner, name, start, end)
+	if err != nil {
+		return result, err
+	}
+
+	result.CommitCount = len(commits)
+	for _, commit := range commits {
+		date := commit.Date
+		if result.FirstCommitAt == nil || date.Before(*result.FirstCommitAt) {
+			result.FirstCommitAt = &date
+		}
+		if result.LastCommitAt == nil || date.After(*result.LastCommitAt) {
+			result.LastCommitAt = &date
+		}
+	}
+	if len(commits) == 0 {
+		result.Issues = append(result.Issues, "no commits during the hackathon window")
+	}
+
+	contributors, err := utils.Github.ListContributors(ctx, owner, name)
+	if err != nil {
+		return result, err
+	}
+
+	members, err := utils.Queries.GetTeamMembers(ctx, uuid.NullUUID{UUID: teamId, Valid: true})
+	if err != nil {
+		return result, err
+	}
+
+	logins := make(map[string]bool, len(members))
+	for _, member := range members {
+		if member.GithubProfile != nil && utils.GithubLogin(*member.GithubProfile) != "" {
+			logins[utils.GithubLogin(*member.GithubProfile)] = false
+		}
+	}
+
+	outsiders := 0
+	for _, contributor := range contributors {
+		login := strings.ToLower(contributor.Login)
+		_, isMember := logins[login]
+		if isMember {
+			logins[login] = true
+		} else {
+			outsiders++
+		}
+
+		result.Contributors = append(result.Contributors, dto.RepoContributor{
+			Login:    contributor.Login,
+			Commits:  contributor.Contributions,
+			IsMember: isMember,
+		})
+	}
+
+	for _, member := range members {
+		if member.GithubProfile == nil || utils.GithubLogin(*member.GithubProfile) == "" {
+			result.MissingMembers = append(result.MissingMembers, member.FirstName+" "+member.LastName)
+			continue
+		}
+		if login := utils.GithubLogin(*member.GithubProfile); !logins[login] {
+			result.MissingMembers = append(result.MissingMembers, login)
+		}
+	}
+
+	if outsiders > 0 {
+		result.Issues = append(result.Issues, "repository has contributors who are not on the team")
+	}
+
+	return result, nil
+}
+
+// cachedRepoValidation validates the team's submitted repository, reusing a
+// recent result from redis unless refresh is set.
+func cachedRepoValidation(ctx context.Context, teamId uuid.UUID, refresh bool) (dto.RepoValidation, error) {
+	key := "repo_validation:" + teamId.String()
+
+	if !refresh {
+		if cached, err := utils.RedisClient.Get(ctx, key).Bytes(); err == nil {
+			var result dto.RepoValidation
+			if json.Unmarshal(cached, &result) == nil {
+				return result, nil
+			}
+		}
+	}
+
+	submission, err := utils.Queries.GetSubmissionByTeamID(ctx, teamId)
+	if err != nil {
+		return dto.RepoValidation{}, err
+	}
+
+	result, err := validateRepo(ctx, teamId, submission.GithubLink)
+	if err != nil {
+		return result, err
+	}
+
+	if data, err := json.Marshal(result); err == nil {
+		utils.RedisClient.Set(ctx, key, data, repoValidationTTL)
+	}
+
+	return result, nil
+}
+
+func repoValidationResponse(c echo.Context, teamId uuid.UUID) error {
+	result, err := cachedRepoValidation(c.Request().Context(), teamId, c.QueryParam("refresh") == "true")
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return c.JSON(http.StatusNotFound, &models.Response{
+				Status:  "fail",
+				Message: "Submission not found",
+			})
+		}
+		logger.Errorf(logger.InternalError, err.Error())
+		return c.JSON(http.StatusBadGateway, &models.Response{
+			Status:  "fail",
+			Message: "Failed to validate repository",
+		})
+	}
+
+	return c.JSON(http.StatusOK, &models.Response{
+		Status:  "success",
+		Message: "Repository validated successfully",
+		Data:    result,
+	})
+}
+
+func GetUserRepoValidation(c echo.Context) error {
+	user, ok := c.Get("user").(db.User)
+	if !ok || !user.TeamID.Valid {
+		return c.JSON(http.StatusForbidden, &models.Response{
+			Status:  "fail",
+			Message: "User does not belong to any team",
+		})
+	}
+
+	return repoValidationResponse(c, user.TeamID.UUID)
+}
+
+func GetRepoValidation(c echo.Context) error {
+	teamId, err := uuid.Parse(c.Param("teamId"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: "Invalid team ID format",
+		})
+	}
+
+	return repoValidationResponse(c, teamId)
+}
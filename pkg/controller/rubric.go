@@ -0,0 +1,386 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/CodeChefVIT/devsoc-be-24/pkg/db"
+	logger "github.com/CodeChefVIT/devsoc-be-24/pkg/logging"
+	"github.com/CodeChefVIT/devsoc-be-24/pkg/models"
+	"github.com/CodeChefVIT/devsoc-be-24/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/labstack/echo/v4"
+)
+
+// checkScorecard validates points against the round's rubric: every criterion
+// must be scored, within 0 and its max points, and no unknown slugs are allowed.
+func checkScorecard(criteria []db.RubricCriterion, round int32, scores map[string]int) error {
+	if len(criteria) == 0 {
+		return fmt.Errorf("No judging rubric configured for round %d", round)
+	}
+
+	known := make(map[string]bool, len(criteria))
+	for _, criterion := range criteria {
+		known[criterion.Slug] = true
+		points, ok := scores[criterion.Slug]
+		if !ok {
+			return fmt.Errorf("Missing score for %s", criterion.Slug)
+		}
+		if points < 0 || points > int(criterion.MaxPoints) {
+			return fmt.Errorf("%s must be between 0 and %d", criterion.Slug, criterion.MaxPoints)
+		}
+	}
+
+	for slug := range scores {
+		if !known[slug] {
+			return fmt.Errorf("Unknown criterion %s for round %d", slug, round)
+		}
+	}
+	return nil
+}
+
+// saveScorecard writes the score row with save, replaces its per-criterion
+// points and appends the change to the score audit log in one transaction.
+// It fails with roundClosedError unless the score's round is still open.
+func saveScorecard(ctx context.Context, actor db.User, action, reason string, score db.Score, criteria []db.RubricCriterion, scores map[string]int, save func(q *db.Queries) error) error {
+	tx, err := utils.DB.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+	qtx := utils.Queries.WithTx(tx)
+
+	if err := lockOpenRound(ctx, qtx, score.Round); err != nil {
+		return err
+	}
+
+	var before []byte
+	if action == "update" {
+		if before, err = qtx.GetScoreSnapshot(ctx, score.ID); err != nil {
+			return err
+		}
+	}
+
+	if err := save(qtx); err != nil {
+		return err
+	}
+
+	if err := qtx.DeleteScoreCriteria(ctx, score.ID); err != nil {
+		return err
+	}
+
+	for _, criterion := range criteria {
+		if err := qtx.CreateScoreCriterion(ctx, db.CreateScoreCriterionParams{
+			ScoreID:     score.ID,
+			CriterionID: criterion.ID,
+			Points:      int32(scores[criterion.Slug]),
+		}); err != nil {
+			return err
+		}
+	}
+
+	if err := recordScoreAudit(ctx, qtx, actor, action, reason, score, before); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// rubricConflict explains why the rubric of a round cannot change, or returns
+// an empty string when it can. Criteria are only editable while the round is
+// open for judging.
+func rubricConflict(ctx context.Context, round int32) (string, error) {
+	state, err := roundState(ctx, round)
+	if err != nil {
+		return "", err
+	}
+	if state != "open" {
+		return fmt.Sprintf("Judging round %d is %s, reopen it to change the rubric", round, state), nil
+	}
+	return "", nil
+}
+
+// editableCriterion fetches a criterion that is about to change. On failure
+// it returns the status and message to respond with.
+func editableCriterion(ctx context.Context, id uuid.UUID) (db.RubricCriterion, int, string) {
+	criterion, err := utils.Queries.GetRubricCriterion(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return criterion, http.StatusNotFound, "Criterion not found"
+	}
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return criterion, http.StatusInternalServerError, "Failed to fetch criterion"
+	}
+
+	conflict, err := rubricConflict(ctx, criterion.Round)
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return criterion, http.StatusInternalServerError, "Failed to fetch judging round"
+	}
+	if conflict != "" {
+		return criterion, http.StatusConflict, conflict
+	}
+	return criterion, 0, ""
+}
+
+func GetRubric(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	if param := c.QueryParam("round"); param != "" {
+		round, ok := parseRound(param)
+		if !ok {
+			return c.JSON(http.StatusBadRequest, &models.Response{
+				Status:  "fail",
+				Message: "Invalid round",
+			})
+		}
+
+		criteria, err := utils.Queries.GetRubricCriteria(ctx, round)
+		if err != nil {
+			logger.Errorf(logger.DatabaseError, err.Error())
+			return c.JSON(http.StatusInternalServerError, &models.Response{
+				Status:  "fail",
+				Message: "Failed to fetch rubric",
+			})
+		}
+
+		return c.JSON(http.StatusOK, &models.Response{
+			Status:  "success",
+			Message: "Rubric fetched successfully",
+			Data:    criteria,
+		})
+	}
+
+	criteria, err := utils.Queries.ListRubricCriteria(ctx)
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to fetch rubric",
+		})
+	}
+
+	return c.JSON(http.StatusOK, &models.Response{
+		Status:  "success",
+		Message: "Rubric fetched successfully",
+		Data:    criteria,
+	})
+}
+
+func CreateRubricCriterion(c echo.Context) error {
+	var payload models.RubricCriterion
+
+	if err := c.Bind(&payload); err != nil {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: "Invalid request body",
+		})
+	}
+
+	if err := utils.Validate.Struct(payload); err != nil {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: utils.FormatValidationErrors(err),
+		})
+	}
+
+	ctx := c.Request().Context()
+
+	conflict, err := rubricConflict(ctx, payload.Round)
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to fetch judging round",
+		})
+	}
+	if conflict != "" {
+		return c.JSON(http.StatusConflict, &models.Response{
+			Status:  "fail",
+			Message: conflict,
+		})
+	}
+
+	// Every scorecard must cover the whole rubric, so a criterion added after
+	// judging started would make the existing scorecards impossible to update.
+	scored, err := utils.Queries.CountRoundScores(ctx, payload.Round)
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to fetch scores",
+		})
+	}
+	if scored > 0 {
+		return c.JSON(http.StatusConflict, &models.Response{
+			Status:  "fail",
+			Message: fmt.Sprintf("Round %d already has scores, criteria can no longer be added", payload.Round),
+		})
+	}
+
+	slug := payload.Slug
+	if slug == "" {
+		slug = payload.Name
+	}
+	slug = utils.TrackSlug(slug)
+	if slug == "" {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: "Criterion slug must contain letters or digits",
+		})
+	}
+
+	id, _ := uuid.NewV7()
+	criterion, err := utils.Queries.CreateRubricCriterion(ctx, db.CreateRubricCriterionParams{
+		ID:          id,
+		Round:       payload.Round,
+		Slug:        slug,
+		Name:        payload.Name,
+		Description: payload.Description,
+		Weight:      payload.Weight,
+		MaxPoints:   payload.MaxPoints,
+		Position:    payload.Position,
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return c.JSON(http.StatusConflict, &models.Response{
+				Status:  "fail",
+				Message: "A criterion with this slug already exists for the round",
+			})
+		}
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to create criterion",
+		})
+	}
+
+	return c.JSON(http.StatusCreated, &models.Response{
+		Status:  "success",
+		Message: "Criterion created successfully",
+		Data:    criterion,
+	})
+}
+
+func UpdateRubricCriterion(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: "Invalid criterion ID format",
+		})
+	}
+
+	var payload models.RubricCriterion
+
+	if err := c.Bind(&payload); err != nil {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: "Invalid request body",
+		})
+	}
+
+	if err := utils.Validate.Struct(payload); err != nil {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: utils.FormatValidationErrors(err),
+		})
+	}
+
+	ctx := c.Request().Context()
+
+	current, status, message := editableCriterion(ctx, id)
+	if message != "" {
+		return c.JSON(status, &models.Response{
+			Status:  "fail",
+			Message: message,
+		})
+	}
+
+	stored, err := utils.Queries.GetCriterionMaxStoredPoints(ctx, current.ID)
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to fetch scores",
+		})
+	}
+	if payload.MaxPoints < stored {
+		return c.JSON(http.StatusConflict, &models.Response{
+			Status:  "fail",
+			Message: fmt.Sprintf("max_points cannot be below %d, the highest points already given for %s", stored, current.Slug),
+		})
+	}
+
+	criterion, err := utils.Queries.UpdateRubricCriterion(ctx, db.UpdateRubricCriterionParams{
+		ID:          id,
+		Name:        payload.Name,
+		Description: payload.Description,
+		Weight:      payload.Weight,
+		MaxPoints:   payload.MaxPoints,
+		Position:    payload.Position,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return c.JSON(http.StatusNotFound, &models.Response{
+				Status:  "fail",
+				Message: "Criterion not found",
+			})
+		}
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to update criterion",
+		})
+	}
+
+	return c.JSON(http.StatusOK, &models.Response{
+		Status:  "success",
+		Message: "Criterion updated successfully",
+		Data:    criterion,
+	})
+}
+
+func DeleteRubricCriterion(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: "Invalid criterion ID format",
+		})
+	}
+
+	ctx := c.Request().Context()
+
+	if _, status, message := editableCriterion(ctx, id); message != "" {
+		return c.JSON(status, &models.Response{
+			Status:  "fail",
+			Message: message,
+		})
+	}
+
+	if err := utils.Queries.DeleteRubricCriterion(ctx, id); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			return c.JSON(http.StatusConflict, &models.Response{
+				Status:  "fail",
+				Message: "Criterion is still used by scores",
+			})
+		}
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to delete criterion",
+		})
+	}
+
+	return c.JSON(http.StatusOK, &models.Response{
+		Status:  "success",
+		Message: "Criterion deleted successfully",
+	})
+}
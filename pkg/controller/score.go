@@ -48,18 +48,36 @@ func GetScore(c echo.Context) error {
 		)
 	}
 
+	points, err := utils.Queries.GetTeamScoreCriteria(ctx, teamUuid)
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: err.Error(),
+		})
+	}
+
+	criteria := make(map[uuid.UUID]map[string]int, len(teamScore))
+	for _, point := range points {
+		if criteria[point.ScoreID] == nil {
+			criteria[point.ScoreID] = make(map[string]int)
+		}
+		criteria[point.ScoreID][point.Slug] = int(point.Points)
+	}
+
 	scores := make([]models.GetScore, len(teamScore))
 	for i := 0; i < len(teamScore); i++ {
 		scores[i] = models.GetScore{
-			Id:             teamScore[i].ID.String(),
-			TeamID:         teamId,
-			Design:         int(teamScore[i].Design),
-			Implementation: int(teamScore[i].Implementation),
-			Presentation:   int(teamScore[i].Presentation),
-			Innovation:     int(teamScore[i].Innovation),
-			Teamwork:       int(teamScore[i].Teamwork),
-			Comment:        getSafeString(teamScore[i].Comment),
-			Round:          int(teamScore[i].Round),
+			Id:       teamScore[i].ID.String(),
+			TeamID:   teamId,
+			Criteria: criteria[teamScore[i].ID],
+			Total:    teamScore[i].Total,
+			Judge:    getSafeString(teamScore[i].Judge),
+			Comment:  getSafeString(teamScore[i].Comment),
+			Round:    int(teamScore[i].Round),
+		}
+		if teamScore[i].PanelID.Valid {
+			scores[i].PanelID = teamScore[i].PanelID.UUID.String()
 		}
 	}
 
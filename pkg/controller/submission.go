@@ -2,6 +2,7 @@ package controller
 
 import (
 	"errors"
+	"fmt"
 	"net/http"
 
 	"github.com/CodeChefVIT/devsoc-be-24/pkg/db"
@@ -32,7 +33,27 @@ func GetUserSubmission(c echo.Context) error {
 		})
 	}
 
-	submission, err := utils.Queries.GetSubmissionByTeamID(ctx, teamUuid)
+	team, err := utils.Queries.GetTeamByTeamId(ctx, teamUuid)
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to fetch team",
+		})
+	}
+
+	round, ok := submissionRound(c, team)
+	if !ok {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: "Invalid round",
+		})
+	}
+
+	submission, err := utils.Queries.GetSubmissionByRound(ctx, db.GetSubmissionByRoundParams{
+		TeamID: teamUuid,
+		Round:  round,
+	})
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return c.JSON(http.StatusNotFound, &models.Response{
@@ -57,6 +78,8 @@ func GetUserSubmission(c echo.Context) error {
 			FigmaLink:   submission.FigmaLink,
 			OtherLink:   submission.OtherLink,
 			TeamID:      submission.TeamID.String(),
+			IsLate:      submission.IsLate,
+			Round:       submission.Round,
 		},
 	})
 }
@@ -93,18 +116,55 @@ func CreateSubmission(c echo.Context) error {
 		})
 	}
 
+	round, _ := c.Get("round").(int32)
+	late, _ := c.Get("late").(bool)
+
+	missing, err := missingSubmissionFields(ctx, round, req.Fields())
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to fetch submission requirements",
+		})
+	}
+	if len(missing) > 0 {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: fmt.Sprintf("%s field is required for round %d", missing[0], round),
+			Data:    missing,
+		})
+	}
+
+	track, err := resolveTrack(ctx, req.Track)
+	if err != nil {
+		return trackError(c, err)
+	}
+
 	teamUuid := user.TeamID.UUID
 
+	tx, err := utils.DB.Begin(ctx)
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "failed to create submission",
+		})
+	}
+	defer tx.Rollback(ctx)
+	qtx := utils.Queries.WithTx(tx)
+
 	submission_id, _ := uuid.NewV7()
-	submission, err := utils.Queries.CreateSubmission(ctx, db.CreateSubmissionParams{
+	submission, err := qtx.CreateSubmission(ctx, db.CreateSubmissionParams{
 		ID:          submission_id,
 		Title:       req.Title,
 		Description: req.Description,
-		Track:       req.Track,
+		Track:       track.Slug,
 		TeamID:      teamUuid,
 		GithubLink:  req.GithubLink,
 		FigmaLink:   req.FigmaLink,
 		OtherLink:   req.OtherLink,
+		IsLate:      late,
+		Round:       round,
 	})
 
 	if err != nil {
@@ -115,6 +175,22 @@ func CreateSubmission(c echo.Context) error {
 		})
 	}
 
+	if err := recordSubmissionVersion(ctx, qtx, user, submission); err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "failed to create submission",
+		})
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "failed to create submission",
+		})
+	}
+
 	return c.JSON(http.StatusCreated, &models.Response{
 		Status: "success",
 		Data: dto.Submission{
@@ -125,6 +201,8 @@ func CreateSubmission(c echo.Context) error {
 			GithubLink:  submission.GithubLink,
 			FigmaLink:   submission.FigmaLink,
 			OtherLink:   submission.OtherLink,
+			IsLate:      submission.IsLate,
+			Round:       submission.Round,
 		},
 	})
 }
@@ -170,14 +248,51 @@ func UpdateSubmission(c echo.Context) error {
 		})
 	}
 
-	submission, err := utils.Queries.UpdateSubmission(ctx, db.UpdateSubmissionParams{
+	round, _ := c.Get("round").(int32)
+	late, _ := c.Get("late").(bool)
+
+	missing, err := missingSubmissionFields(ctx, round, req.Fields())
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to fetch submission requirements",
+		})
+	}
+	if len(missing) > 0 {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: fmt.Sprintf("%s field is required for round %d", missing[0], round),
+			Data:    missing,
+		})
+	}
+
+	track, err := resolveTrack(ctx, req.Track)
+	if err != nil {
+		return trackError(c, err)
+	}
+
+	tx, err := utils.DB.Begin(ctx)
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "failed to update submission",
+		})
+	}
+	defer tx.Rollback(ctx)
+	qtx := utils.Queries.WithTx(tx)
+
+	submission, err := qtx.UpdateSubmission(ctx, db.UpdateSubmissionParams{
 		TeamID:      teamUuid,
 		Title:       req.Title,
 		Description: req.Description,
-		Track:       req.Track,
+		Track:       track.Slug,
 		GithubLink:  req.GithubLink,
 		FigmaLink:   req.FigmaLink,
 		OtherLink:   req.OtherLink,
+		IsLate:      late,
+		Round:       round,
 	})
 
 	if err != nil {
@@ -188,6 +303,22 @@ func UpdateSubmission(c echo.Context) error {
 		})
 	}
 
+	if err := recordSubmissionVersion(ctx, qtx, user, submission); err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "failed to update submission",
+		})
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "failed to update submission",
+		})
+	}
+
 	return c.JSON(http.StatusOK, &models.Response{
 		Status: "success",
 		Data: dto.Submission{
@@ -198,6 +329,8 @@ func UpdateSubmission(c echo.Context) error {
 			GithubLink:  submission.GithubLink,
 			FigmaLink:   submission.FigmaLink,
 			OtherLink:   submission.OtherLink,
+			IsLate:      submission.IsLate,
+			Round:       submission.Round,
 		},
 	})
 }
@@ -228,7 +361,23 @@ func DeleteSubmission(c echo.Context) error {
 		})
 	}
 
-	err := utils.Queries.DeleteSubmission(ctx, teamUuid)
+	tx, err := utils.DB.Begin(ctx)
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "failed to delete submission",
+		})
+	}
+	defer tx.Rollback(ctx)
+	qtx := utils.Queries.WithTx(tx)
+
+	round, _ := c.Get("round").(int32)
+
+	err = qtx.DeleteSubmission(ctx, db.DeleteSubmissionParams{
+		TeamID: teamUuid,
+		Round:  round,
+	})
 	if err != nil {
 		logger.Errorf(logger.InternalError, err.Error())
 		return c.JSON(http.StatusBadRequest, &models.Response{
@@ -237,6 +386,32 @@ func DeleteSubmission(c echo.Context) error {
 		})
 	}
 
+	keys, err := qtx.DeleteSubmissionAttachmentsByTeam(ctx, db.DeleteSubmissionAttachmentsByTeamParams{
+		TeamID: teamUuid,
+		Round:  round,
+	})
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "failed to delete submission",
+		})
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "failed to delete submission",
+		})
+	}
+
+	for _, key := range keys {
+		if err := utils.Store.Delete(ctx, key); err != nil {
+			logger.Errorf(logger.InternalError, err.Error())
+		}
+	}
+
 	return c.JSON(http.StatusOK, &models.Response{
 		Status: "success",
 		Message: "Submission deleted successfully",
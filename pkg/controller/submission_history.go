@@ -0,0 +1,248 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/CodeChefVIT/devsoc-be-24/pkg/db"
+	"github.com/CodeChefVIT/devsoc-be-24/pkg/dto"
+	logger "github.com/CodeChefVIT/devsoc-be-24/pkg/logging"
+	"github.com/CodeChefVIT/devsoc-be-24/pkg/models"
+	"github.com/CodeChefVIT/devsoc-be-24/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/labstack/echo/v4"
+)
+
+func recordSubmissionVersion(ctx context.Context, q *db.Queries, author db.User, submission db.Submission) error {
+	id, _ := uuid.NewV7()
+	return q.CreateSubmissionVersion(ctx, db.CreateSubmissionVersionParams{
+		ID:          id,
+		TeamID:      submission.TeamID,
+		Round:       submission.Round,
+		Title:       submission.Title,
+		Description: submission.Description,
+		Track:       submission.Track,
+		GithubLink:  submission.GithubLink,
+		FigmaLink:   submission.FigmaLink,
+		OtherLink:   submission.OtherLink,
+		IsLate:      submission.IsLate,
+		AuthorID:    uuid.NullUUID{UUID: author.ID, Valid: true},
+	})
+}
+
+func submissionHistoryURL(teamId uuid.UUID, round int32) string {
+	return fmt.Sprintf("/panel/submission/%s/history?round=%d", teamId, round)
+}
+
+func submissionHistory(c echo.Context, teamId uuid.UUID) error {
+	ctx := c.Request().Context()
+
+	team, err := utils.Queries.GetTeamByTeamId(ctx, teamId)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return c.JSON(http.StatusNotFound, &models.Response{
+				Status:  "fail",
+				Message: "Team not found",
+			})
+		}
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to fetch team",
+		})
+	}
+
+	round, ok := submissionRound(c, team)
+	if !ok {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: "Invalid round",
+		})
+	}
+
+	rows, err := utils.Queries.GetSubmissionVersions(ctx, db.GetSubmissionVersionsParams{
+		TeamID: teamId,
+		Round:  round,
+	})
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to fetch submission history",
+		})
+	}
+
+	versions := make([]dto.SubmissionVersion, 0, len(rows))
+	for _, row := range rows {
+		versions = append(versions, dto.SubmissionVersion{
+			Version: row.Version,
+			Submission: dto.Submission{
+				Title:       row.Title,
+				Description: row.Description,
+				Track:       row.Track,
+				GithubLink:  row.GithubLink,
+				FigmaLink:   row.FigmaLink,
+				OtherLink:   row.OtherLink,
+				TeamID:      teamId.String(),
+				IsLate:      row.IsLate,
+				Round:       round,
+			},
+			Author:    strings.TrimSpace(getSafeString(row.FirstName) + " " + getSafeString(row.LastName)),
+			CreatedAt: row.CreatedAt.Time,
+		})
+	}
+
+	return c.JSON(http.StatusOK, &models.Response{
+		Status:  "success",
+		Message: "Submission history fetched successfully",
+		Data:    versions,
+	})
+}
+
+func GetUserSubmissionHistory(c echo.Context) error {
+	user, ok := c.Get("user").(db.User)
+	if !ok || !user.TeamID.Valid {
+		return c.JSON(http.StatusForbidden, &models.Response{
+			Status:  "fail",
+			Message: "User does not belong to any team",
+		})
+	}
+
+	return submissionHistory(c, user.TeamID.UUID)
+}
+
+func GetSubmissionHistory(c echo.Context) error {
+	teamId, err := uuid.Parse(c.Param("teamId"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: "Invalid team ID format",
+		})
+	}
+
+	return submissionHistory(c, teamId)
+}
+
+func FreezeSubmissions(c echo.Context) error {
+	var payload models.FreezeSubmissions
+
+	if err := c.Bind(&payload); err != nil {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: "Invalid request body",
+		})
+	}
+
+	if err := utils.Validate.Struct(payload); err != nil {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: utils.FormatValidationErrors(err),
+		})
+	}
+
+	ctx := c.Request().Context()
+	actor := c.Get("user").(db.User)
+
+	tx, err := utils.DB.Begin(ctx)
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to start transaction",
+		})
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := utils.Queries.WithTx(tx)
+
+	frozen, err := qtx.FreezeSubmissions(ctx, db.FreezeSubmissionsParams{
+		Round:    payload.Round,
+		FrozenBy: actor.ID,
+	})
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to freeze submissions",
+		})
+	}
+
+	if err := recordAudit(ctx, qtx, actor, "submission.freeze", uuid.Nil, uuid.NullUUID{},
+		fmt.Sprintf("froze %d submissions for round %d", frozen, payload.Round)); err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to record audit log",
+		})
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to freeze submissions",
+		})
+	}
+
+	return c.JSON(http.StatusOK, &models.Response{
+		Status:  "success",
+		Message: "Submissions frozen successfully",
+		Data: map[string]interface{}{
+			"round":  payload.Round,
+			"frozen": frozen,
+		},
+	})
+}
+
+// frozenSubmission returns the snapshot of a team's submission for round,
+// falling back to the live submission when the round has not been frozen yet.
+func frozenSubmission(ctx context.Context, teamId uuid.UUID, round int32) (dto.Submission, error) {
+	snapshot, err := utils.Queries.GetSubmissionSnapshot(ctx, db.GetSubmissionSnapshotParams{
+		TeamID: teamId,
+		Round:  round,
+	})
+	if err == nil {
+		return dto.Submission{
+			Title:       snapshot.Title,
+			Description: snapshot.Description,
+			Track:       snapshot.Track,
+			GithubLink:  snapshot.GithubLink,
+			FigmaLink:   snapshot.FigmaLink,
+			OtherLink:   snapshot.OtherLink,
+			TeamID:      teamId.String(),
+			IsLate:      snapshot.IsLate,
+			Round:       round,
+			Version:     snapshot.Version,
+			Frozen:      true,
+			HistoryURL:  submissionHistoryURL(teamId, round),
+		}, nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return dto.Submission{}, err
+	}
+
+	submission, err := utils.Queries.GetSubmissionByRound(ctx, db.GetSubmissionByRoundParams{
+		TeamID: teamId,
+		Round:  round,
+	})
+	if err != nil {
+		return dto.Submission{}, err
+	}
+
+	return dto.Submission{
+		Title:       submission.Title,
+		Description: submission.Description,
+		Track:       submission.Track,
+		GithubLink:  submission.GithubLink,
+		FigmaLink:   submission.FigmaLink,
+		OtherLink:   submission.OtherLink,
+		TeamID:      teamId.String(),
+		IsLate:      submission.IsLate,
+		Round:       round,
+		HistoryURL:  submissionHistoryURL(teamId, round),
+	}, nil
+}
@@ -0,0 +1,166 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/CodeChefVIT/devsoc-be-24/pkg/db"
+	logger "github.com/CodeChefVIT/devsoc-be-24/pkg/logging"
+	"github.com/CodeChefVIT/devsoc-be-24/pkg/models"
+	"github.com/CodeChefVIT/devsoc-be-24/pkg/utils"
+	"github.com/jackc/pgx/v5"
+	"github.com/labstack/echo/v4"
+)
+
+// submissionRound is the round named by the ?round= query parameter,
+// defaulting to the team's current round. ok is false for malformed rounds.
+func submissionRound(c echo.Context, team db.Team) (int32, bool) {
+	param := c.QueryParam("round")
+	if param == "" {
+		return utils.CurrentRound(team), true
+	}
+	return parseRound(param)
+}
+
+func parseRound(param string) (int32, bool) {
+	round, err := strconv.Atoi(param)
+	if err != nil || round < 1 {
+		return 0, false
+	}
+	return int32(round), true
+}
+
+func requiredSubmissionFields(ctx context.Context, round int32) ([]string, error) {
+	required, err := utils.Queries.GetSubmissionRequirements(ctx, round)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return models.DefaultRequiredFields, nil
+	}
+	return required, err
+}
+
+// missingSubmissionFields lists the fields the round requires that were left
+// empty, in the order of models.SubmissionFields.
+func missingSubmissionFields(ctx context.Context, round int32, fields map[string]string) ([]string, error) {
+	required, err := requiredSubmissionFields(ctx, round)
+	if err != nil {
+		return nil, err
+	}
+
+	missing := []string{}
+	for _, field := range models.SubmissionFields {
+		if slices.Contains(required, field) && strings.TrimSpace(fields[field]) == "" {
+			missing = append(missing, field)
+		}
+	}
+	return missing, nil
+}
+
+func GetSubmissionRequirements(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	user, ok := c.Get("user").(db.User)
+	if !ok || !user.TeamID.Valid {
+		return c.JSON(http.StatusForbidden, &models.Response{
+			Status:  "fail",
+			Message: "User does not belong to any team",
+		})
+	}
+
+	team, err := utils.Queries.GetTeamByTeamId(ctx, user.TeamID.UUID)
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to fetch team",
+		})
+	}
+
+	round, ok := submissionRound(c, team)
+	if !ok {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: "Invalid round",
+		})
+	}
+
+	required, err := requiredSubmissionFields(ctx, round)
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to fetch submission requirements",
+		})
+	}
+
+	return c.JSON(http.StatusOK, &models.Response{
+		Status:  "success",
+		Message: "Submission requirements fetched successfully",
+		Data: models.SubmissionRequirements{
+			Round:          round,
+			RequiredFields: required,
+		},
+	})
+}
+
+func ListSubmissionRequirements(c echo.Context) error {
+	requirements, err := utils.Queries.ListSubmissionRequirements(c.Request().Context())
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to fetch submission requirements",
+		})
+	}
+
+	return c.JSON(http.StatusOK, &models.Response{
+		Status:  "success",
+		Message: "Submission requirements fetched successfully",
+		Data: map[string]interface{}{
+			"requirements": requirements,
+			"default":      models.DefaultRequiredFields,
+		},
+	})
+}
+
+func UpsertSubmissionRequirements(c echo.Context) error {
+	var payload models.SubmissionRequirements
+
+	if err := c.Bind(&payload); err != nil {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: "Invalid request body",
+		})
+	}
+
+	if err := utils.Validate.Struct(payload); err != nil {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: utils.FormatValidationErrors(err),
+		})
+	}
+
+	slices.Sort(payload.RequiredFields)
+	payload.RequiredFields = slices.Compact(payload.RequiredFields)
+
+	requirements, err := utils.Queries.UpsertSubmissionRequirements(c.Request().Context(), db.UpsertSubmissionRequirementsParams{
+		Round:          payload.Round,
+		RequiredFields: payload.RequiredFields,
+	})
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to save submission requirements",
+		})
+	}
+
+	return c.JSON(http.StatusOK, &models.Response{
+		Status:  "success",
+		Message: "Submission requirements saved successfully",
+		Data:    requirements,
+	})
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/labstack/echo/v4"
 
 	"github.com/CodeChefVIT/devsoc-be-24/pkg/db"
+	logger "github.com/CodeChefVIT/devsoc-be-24/pkg/logging"
 	"github.com/CodeChefVIT/devsoc-be-24/pkg/models"
 	"github.com/CodeChefVIT/devsoc-be-24/pkg/utils"
 )
@@ -270,6 +271,14 @@ func CreateTeam(c echo.Context) error {
 
 	payload.Name = strings.TrimSpace(payload.Name)
 
+	verdict, reason := utils.CheckTeamName(payload.Name)
+	if verdict == utils.TeamNameRejected {
+		return c.JSON(http.StatusBadRequest, models.Response{
+			Status:  "fail",
+			Message: "Team name " + reason,
+		})
+	}
+
 	user, ok := c.Get("user").(db.User)
 	if !ok {
 		return c.JSON(http.StatusBadRequest, models.Response{
@@ -353,6 +362,10 @@ func CreateTeam(c echo.Context) error {
 		})
 	}
 
+	if verdict == utils.TeamNameFlagged {
+		flagTeamName(ctx, team.ID, team.Name, reason)
+	}
+
 	return c.JSON(http.StatusOK, models.Response{
 		Status: "success",
 		Message: "Team created",
@@ -437,7 +450,26 @@ func LeaveTeam(c echo.Context) error {
 			})
 		}
 
-		if err := utils.Queries.RemoveTeamIDFromUsers(ctx, nullableTeamID); err != nil {
+		tx, err := utils.DB.Begin(ctx)
+		if err != nil {
+			logger.Errorf(logger.DatabaseError, err.Error())
+			return c.JSON(http.StatusInternalServerError, models.Response{
+				Status:  "fail",
+				Message: "Failed to start transaction",
+			})
+		}
+		defer tx.Rollback(ctx)
+		qtx := utils.Queries.WithTx(tx)
+
+		if err := setScoreAuditContext(ctx, qtx, user, "team deleted when its leader left"); err != nil {
+			logger.Errorf(logger.DatabaseError, err.Error())
+			return c.JSON(http.StatusInternalServerError, models.Response{
+				Status:  "fail",
+				Message: "Failed to record score changes",
+			})
+		}
+
+		if err := qtx.RemoveTeamIDFromUsers(ctx, nullableTeamID); err != nil {
 			return c.JSON(http.StatusBadRequest, models.Response{
 				Status: "fail",
 				Message: "some error occured while leaving team",
@@ -447,7 +479,7 @@ func LeaveTeam(c echo.Context) error {
 			})
 		}
 
-		if err := utils.Queries.DeleteTeam(ctx, user.TeamID.UUID); err != nil {
+		if err := qtx.DeleteTeam(ctx, user.TeamID.UUID); err != nil {
 			return c.JSON(http.StatusBadRequest, models.Response{
 				Status: "fail",
 				Message: "Failed to delete team",
@@ -457,7 +489,7 @@ func LeaveTeam(c echo.Context) error {
 			})
 		}
 
-		if err := utils.Queries.UpdateLeader(ctx, db.UpdateLeaderParams{
+		if err := qtx.UpdateLeader(ctx, db.UpdateLeaderParams{
 			IsLeader: false,
 			ID:       user.ID,
 		}); err != nil {
@@ -469,6 +501,14 @@ func LeaveTeam(c echo.Context) error {
 				},
 			})
 		}
+
+		if err := tx.Commit(ctx); err != nil {
+			logger.Errorf(logger.DatabaseError, err.Error())
+			return c.JSON(http.StatusInternalServerError, models.Response{
+				Status:  "fail",
+				Message: "Failed to delete team",
+			})
+		}
 		user.TeamID = uuid.NullUUID{}
 
 		if err := utils.SendTeamEmail(ctx, emails); err != nil {
@@ -561,7 +601,26 @@ func DeleteTeam(c echo.Context) error {
 		})
 	}
 
-	if err := utils.Queries.RemoveTeamIDFromUsers(ctx, nullableTeamID); err != nil {
+	tx, err := utils.DB.Begin(ctx)
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, models.Response{
+			Status:  "fail",
+			Message: "Failed to start transaction",
+		})
+	}
+	defer tx.Rollback(ctx)
+	qtx := utils.Queries.WithTx(tx)
+
+	if err := setScoreAuditContext(ctx, qtx, user, "team deleted by its leader"); err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, models.Response{
+			Status:  "fail",
+			Message: "Failed to record score changes",
+		})
+	}
+
+	if err := qtx.RemoveTeamIDFromUsers(ctx, nullableTeamID); err != nil {
 		return c.JSON(http.StatusBadRequest, models.Response{
 			Status: "fail",
 			Message: "Cannot remove user from team",
@@ -571,7 +630,7 @@ func DeleteTeam(c echo.Context) error {
 		})
 	}
 
-	if err := utils.Queries.DeleteTeam(ctx, user.TeamID.UUID); err != nil {
+	if err := qtx.DeleteTeam(ctx, user.TeamID.UUID); err != nil {
 		return c.JSON(http.StatusBadRequest, models.Response{
 			Status: "fail",
 			Message: "Failed to delete team",
@@ -581,7 +640,7 @@ func DeleteTeam(c echo.Context) error {
 		})
 	}
 
-	if err := utils.Queries.UpdateLeader(ctx, db.UpdateLeaderParams{
+	if err := qtx.UpdateLeader(ctx, db.UpdateLeaderParams{
 		IsLeader: false,
 		ID:       user.ID,
 	}); err != nil {
@@ -593,6 +652,14 @@ func DeleteTeam(c echo.Context) error {
 			},
 		})
 	}
+
+	if err := tx.Commit(ctx); err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, models.Response{
+			Status:  "fail",
+			Message: "Failed to delete team",
+		})
+	}
 	user.TeamID = uuid.NullUUID{}
 
 	if err := utils.SendTeamEmail(ctx, emails); err != nil {
@@ -645,6 +712,14 @@ func UpdateTeamName(c echo.Context) error {
 		})
 	}
 
+	verdict, reason := utils.CheckTeamName(payload.Name)
+	if verdict == utils.TeamNameRejected {
+		return c.JSON(http.StatusBadRequest, models.Response{
+			Status:  "fail",
+			Message: "Team name " + reason,
+		})
+	}
+
 	if err := utils.Queries.UpdateTeamName(ctx, db.UpdateTeamNameParams{
 		Name: payload.Name,
 		ID:   user.TeamID.UUID,
@@ -668,6 +743,10 @@ func UpdateTeamName(c echo.Context) error {
 		})
 	}
 
+	if verdict == utils.TeamNameFlagged {
+		flagTeamName(ctx, user.TeamID.UUID, payload.Name, reason)
+	}
+
 	return c.JSON(http.StatusOK, models.Response{
 		Status: "success",
 		Message: "Team updated successfully",
@@ -695,8 +774,19 @@ func GetAllTeamUsers(c echo.Context) error {
 		})
 	}
 
+	team, err := utils.Queries.GetTeamByTeamId(ctx, user.TeamID.UUID)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, models.Response{
+			Status:  "fail",
+			Message: "Cannot get team profile",
+		})
+	}
+
 	return c.JSON(http.StatusOK, models.Response{
 		Status: "success",
-		Data:   team_members,
+		Data: map[string]interface{}{
+			"profile": teamProfile(team),
+			"members": team_members,
+		},
 	})
 }
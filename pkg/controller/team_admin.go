@@ -0,0 +1,713 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"html"
+	"net/http"
+
+	"github.com/CodeChefVIT/devsoc-be-24/pkg/db"
+	logger "github.com/CodeChefVIT/devsoc-be-24/pkg/logging"
+	"github.com/CodeChefVIT/devsoc-be-24/pkg/models"
+	"github.com/CodeChefVIT/devsoc-be-24/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/labstack/echo/v4"
+)
+
+const maxTeamSize = 5
+
+// detachMember removes a user from their current team, handing leadership to
+// another member or deleting the team if nobody is left. A deleted team's
+// submissions are cleared first; the storage keys of its attachments are
+// returned so the files can be removed once the transaction commits.
+func detachMember(ctx context.Context, q *db.Queries, actor, member db.User) ([]string, error) {
+	oldTeam := member.TeamID
+
+	if err := q.UpdateUserTeam(ctx, db.UpdateUserTeamParams{
+		TeamID:   uuid.NullUUID{},
+		IsLeader: false,
+		ID:       member.ID,
+	}); err != nil {
+		return nil, err
+	}
+
+	remaining, err := q.CountTeamMembers(ctx, oldTeam)
+	if err != nil {
+		return nil, err
+	}
+
+	if remaining == 0 {
+		if err := setScoreAuditContext(ctx, q, actor, fmt.Sprintf("team deleted when %s left", member.Email)); err != nil {
+			return nil, err
+		}
+		keys, err := clearTeamSubmissions(ctx, q, oldTeam.UUID)
+		if err != nil {
+			return nil, err
+		}
+		return keys, q.DeleteTeam(ctx, oldTeam.UUID)
+	}
+
+	if member.IsLeader {
+		if err := q.PromoteNextLeader(ctx, oldTeam); err != nil {
+			return nil, err
+		}
+	}
+
+	return nil, q.SyncTeamMemberCount(ctx, oldTeam.UUID)
+}
+
+// clearTeamSubmissions deletes a team's submissions together with everything
+// hanging off them: attachments, version history, frozen snapshots, scores
+// and panel assignments. It returns the storage keys of the deleted
+// attachments so the files can be removed once the transaction commits.
+func clearTeamSubmissions(ctx context.Context, q *db.Queries, teamID uuid.UUID) ([]string, error) {
+	keys, err := q.DeleteTeamAttachments(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+	if err := q.DeleteTeamSubmissions(ctx, teamID); err != nil {
+		return nil, err
+	}
+	if err := q.DeleteTeamSubmissionVersions(ctx, teamID); err != nil {
+		return nil, err
+	}
+	if err := q.DeleteTeamSnapshots(ctx, teamID); err != nil {
+		return nil, err
+	}
+	if err := q.DeleteTeamScores(ctx, teamID); err != nil {
+		return nil, err
+	}
+	if err := q.DeleteTeamAssignments(ctx, teamID); err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+// transferTeamSubmissions moves every row cleared by clearTeamSubmissions from
+// source to target. Clear the target first so nothing collides.
+func transferTeamSubmissions(ctx context.Context, q *db.Queries, targetID, sourceID uuid.UUID) error {
+	if err := q.TransferSubmission(ctx, db.TransferSubmissionParams{TargetTeamID: targetID, SourceTeamID: sourceID}); err != nil {
+		return err
+	}
+	if err := q.TransferAttachments(ctx, db.TransferAttachmentsParams{TargetTeamID: targetID, SourceTeamID: sourceID}); err != nil {
+		return err
+	}
+	if err := q.TransferSubmissionVersions(ctx, db.TransferSubmissionVersionsParams{TargetTeamID: targetID, SourceTeamID: sourceID}); err != nil {
+		return err
+	}
+	if err := q.TransferSnapshots(ctx, db.TransferSnapshotsParams{TargetTeamID: targetID, SourceTeamID: sourceID}); err != nil {
+		return err
+	}
+	if err := q.TransferScores(ctx, db.TransferScoresParams{TargetTeamID: targetID, SourceTeamID: sourceID}); err != nil {
+		return err
+	}
+	return q.TransferAssignments(ctx, db.TransferAssignmentsParams{TargetTeamID: targetID, SourceTeamID: sourceID})
+}
+
+func deleteStoredFiles(ctx context.Context, keys []string) {
+	for _, key := range keys {
+		if err := utils.Store.Delete(ctx, key); err != nil {
+			logger.Errorf(logger.InternalError, err.Error())
+		}
+	}
+}
+
+func AdminAddTeamMember(c echo.Context) error {
+	var payload models.AdminTeamMember
+
+	if err := c.Bind(&payload); err != nil {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: "Invalid request body",
+		})
+	}
+
+	if err := utils.Validate.Struct(payload); err != nil {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status: "fail",
+			Data:   utils.FormatValidationErrors(err),
+		})
+	}
+
+	ctx := c.Request().Context()
+	actor := c.Get("user").(db.User)
+
+	team, err := utils.Queries.GetTeamByTeamId(ctx, payload.TeamID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return c.JSON(http.StatusNotFound, &models.Response{
+				Status:  "fail",
+				Message: "Team not found",
+			})
+		}
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to fetch team",
+		})
+	}
+
+	member, err := utils.Queries.GetUserByID(ctx, payload.UserID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return c.JSON(http.StatusNotFound, &models.Response{
+				Status:  "fail",
+				Message: "User not found",
+			})
+		}
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to fetch user",
+		})
+	}
+
+	if member.Role != "student" {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: "Only participants can be added to a team",
+		})
+	}
+
+	if member.TeamID.Valid && member.TeamID.UUID == team.ID {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: "User is already a member of this team",
+		})
+	}
+
+	tx, err := utils.DB.Begin(ctx)
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to start transaction",
+		})
+	}
+	defer tx.Rollback(ctx)
+	qtx := utils.Queries.WithTx(tx)
+
+	// Concurrent adds to the same team wait here, so each one counts the
+	// members the previous one committed.
+	if err := qtx.LockTeam(ctx, team.ID); err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to lock team",
+		})
+	}
+
+	count, err := qtx.CountTeamMembers(ctx, uuid.NullUUID{UUID: team.ID, Valid: true})
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to get team members count",
+		})
+	}
+
+	if count >= maxTeamSize {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: "Team is already full",
+		})
+	}
+
+	var staleKeys []string
+	details := fmt.Sprintf("added %s to %s", member.Email, team.Name)
+	if member.TeamID.Valid {
+		details = fmt.Sprintf("moved %s from team %s to %s", member.Email, member.TeamID.UUID, team.Name)
+		staleKeys, err = detachMember(ctx, qtx, actor, member)
+		if err != nil {
+			logger.Errorf(logger.DatabaseError, err.Error())
+			return c.JSON(http.StatusInternalServerError, &models.Response{
+				Status:  "fail",
+				Message: "Failed to remove user from their current team",
+			})
+		}
+	}
+
+	if err := qtx.UpdateUserTeam(ctx, db.UpdateUserTeamParams{
+		TeamID:   uuid.NullUUID{UUID: team.ID, Valid: true},
+		IsLeader: false,
+		ID:       member.ID,
+	}); err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to add user to team",
+		})
+	}
+
+	if err := qtx.SyncTeamMemberCount(ctx, team.ID); err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to update team size",
+		})
+	}
+
+	if err := recordAudit(ctx, qtx, actor, "team.member.add", team.ID,
+		uuid.NullUUID{UUID: member.ID, Valid: true}, details); err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to record audit log",
+		})
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to add user to team",
+		})
+	}
+
+	deleteStoredFiles(ctx, staleKeys)
+
+	go utils.SendBulkEmail([]string{member.Email}, "Team Updated",
+		fmt.Sprintf("The organisers have added you to the team <strong>%s</strong>.", html.EscapeString(team.Name)))
+
+	return c.JSON(http.StatusOK, &models.Response{
+		Status:  "success",
+		Message: "User added to team successfully",
+	})
+}
+
+func AdminRemoveTeamMember(c echo.Context) error {
+	var payload models.AdminTeamMember
+
+	if err := c.Bind(&payload); err != nil {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: "Invalid request body",
+		})
+	}
+
+	if err := utils.Validate.Struct(payload); err != nil {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status: "fail",
+			Data:   utils.FormatValidationErrors(err),
+		})
+	}
+
+	ctx := c.Request().Context()
+	actor := c.Get("user").(db.User)
+
+	team, err := utils.Queries.GetTeamByTeamId(ctx, payload.TeamID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return c.JSON(http.StatusNotFound, &models.Response{
+				Status:  "fail",
+				Message: "Team not found",
+			})
+		}
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to fetch team",
+		})
+	}
+
+	member, err := utils.Queries.GetUserByID(ctx, payload.UserID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return c.JSON(http.StatusNotFound, &models.Response{
+				Status:  "fail",
+				Message: "User not found",
+			})
+		}
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to fetch user",
+		})
+	}
+
+	if !member.TeamID.Valid || member.TeamID.UUID != team.ID {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: "User is not a member of this team",
+		})
+	}
+
+	tx, err := utils.DB.Begin(ctx)
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to start transaction",
+		})
+	}
+	defer tx.Rollback(ctx)
+	qtx := utils.Queries.WithTx(tx)
+
+	staleKeys, err := detachMember(ctx, qtx, actor, member)
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to remove user from team",
+		})
+	}
+
+	if err := recordAudit(ctx, qtx, actor, "team.member.remove", team.ID,
+		uuid.NullUUID{UUID: member.ID, Valid: true},
+		fmt.Sprintf("removed %s from %s", member.Email, team.Name)); err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to record audit log",
+		})
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to remove user from team",
+		})
+	}
+
+	deleteStoredFiles(ctx, staleKeys)
+
+	go utils.SendBulkEmail([]string{member.Email}, "Team Updated",
+		fmt.Sprintf("The organisers have removed you from the team <strong>%s</strong>. You are requested to join another team or create a new team to continue.", html.EscapeString(team.Name)))
+
+	return c.JSON(http.StatusOK, &models.Response{
+		Status:  "success",
+		Message: "User removed from team successfully",
+	})
+}
+
+func MergeTeams(c echo.Context) error {
+	var payload models.MergeTeams
+
+	if err := c.Bind(&payload); err != nil {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: "Invalid request body",
+		})
+	}
+
+	if err := utils.Validate.Struct(payload); err != nil {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status: "fail",
+			Data:   utils.FormatValidationErrors(err),
+		})
+	}
+
+	if payload.TargetTeamID == payload.SourceTeamID {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: "Cannot merge a team into itself",
+		})
+	}
+
+	ctx := c.Request().Context()
+	actor := c.Get("user").(db.User)
+
+	target, err := utils.Queries.GetTeamByTeamId(ctx, payload.TargetTeamID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return c.JSON(http.StatusNotFound, &models.Response{
+				Status:  "fail",
+				Message: "Team not found",
+			})
+		}
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to fetch team",
+		})
+	}
+
+	source, err := utils.Queries.GetTeamByTeamId(ctx, payload.SourceTeamID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return c.JSON(http.StatusNotFound, &models.Response{
+				Status:  "fail",
+				Message: "Team not found",
+			})
+		}
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to fetch team",
+		})
+	}
+
+	targetID := uuid.NullUUID{UUID: target.ID, Valid: true}
+	sourceID := uuid.NullUUID{UUID: source.ID, Valid: true}
+
+	targetEmails, err := utils.Queries.GetTeamUsersEmails(ctx, targetID)
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to get team members",
+		})
+	}
+
+	sourceEmails, err := utils.Queries.GetTeamUsersEmails(ctx, sourceID)
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to get team members",
+		})
+	}
+
+	if len(targetEmails)+len(sourceEmails) > maxTeamSize {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: fmt.Sprintf("Merged team would have more than %d members", maxTeamSize),
+		})
+	}
+
+	tx, err := utils.DB.Begin(ctx)
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to start transaction",
+		})
+	}
+	defer tx.Rollback(ctx)
+	qtx := utils.Queries.WithTx(tx)
+
+	if err := setScoreAuditContext(ctx, qtx, actor, fmt.Sprintf("merged %s into %s", source.Name, target.Name)); err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to record score changes",
+		})
+	}
+
+	if payload.KeepIdea == "source" {
+		if err := qtx.DeleteIdeaByTeamID(ctx, target.ID); err != nil {
+			logger.Errorf(logger.DatabaseError, err.Error())
+			return c.JSON(http.StatusInternalServerError, &models.Response{
+				Status:  "fail",
+				Message: "Failed to replace idea",
+			})
+		}
+		if err := qtx.TransferIdea(ctx, db.TransferIdeaParams{
+			TargetTeamID: target.ID,
+			SourceTeamID: source.ID,
+		}); err != nil {
+			logger.Errorf(logger.DatabaseError, err.Error())
+			return c.JSON(http.StatusInternalServerError, &models.Response{
+				Status:  "fail",
+				Message: "Failed to transfer idea",
+			})
+		}
+	}
+
+	// The submission side that is not kept is cleared explicitly rather than
+	// left to the cascade, so its stored files can be removed after commit.
+	var staleKeys []string
+	if payload.KeepSubmission == "source" {
+		staleKeys, err = clearTeamSubmissions(ctx, qtx, target.ID)
+		if err != nil {
+			logger.Errorf(logger.DatabaseError, err.Error())
+			return c.JSON(http.StatusInternalServerError, &models.Response{
+				Status:  "fail",
+				Message: "Failed to replace submission",
+			})
+		}
+		if err := transferTeamSubmissions(ctx, qtx, target.ID, source.ID); err != nil {
+			logger.Errorf(logger.DatabaseError, err.Error())
+			return c.JSON(http.StatusInternalServerError, &models.Response{
+				Status:  "fail",
+				Message: "Failed to transfer submission",
+			})
+		}
+	} else {
+		staleKeys, err = clearTeamSubmissions(ctx, qtx, source.ID)
+		if err != nil {
+			logger.Errorf(logger.DatabaseError, err.Error())
+			return c.JSON(http.StatusInternalServerError, &models.Response{
+				Status:  "fail",
+				Message: "Failed to delete merged submission",
+			})
+		}
+	}
+
+	if err := qtx.MoveTeamMembers(ctx, db.MoveTeamMembersParams{
+		TargetTeamID: targetID,
+		SourceTeamID: sourceID,
+	}); err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to move team members",
+		})
+	}
+
+	if err := qtx.DeleteTeam(ctx, source.ID); err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to delete merged team",
+		})
+	}
+
+	if err := qtx.SyncTeamMemberCount(ctx, target.ID); err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to update team size",
+		})
+	}
+
+	if err := recordAudit(ctx, qtx, actor, "team.merge", target.ID, sourceID,
+		fmt.Sprintf("merged %s into %s (idea: %s, submission: %s)",
+			source.Name, target.Name, payload.KeepIdea, payload.KeepSubmission)); err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to record audit log",
+		})
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to merge teams",
+		})
+	}
+
+	deleteStoredFiles(ctx, staleKeys)
+
+	go utils.SendBulkEmail(append(targetEmails, sourceEmails...), "Teams Merged",
+		fmt.Sprintf("The organisers have merged the team <strong>%s</strong> into <strong>%s</strong>. You are now a member of <strong>%s</strong>.",
+			html.EscapeString(source.Name), html.EscapeString(target.Name), html.EscapeString(target.Name)))
+
+	return c.JSON(http.StatusOK, &models.Response{
+		Status:  "success",
+		Message: "Teams merged successfully",
+	})
+}
+
+func DisbandTeam(c echo.Context) error {
+	var payload models.DisbandTeam
+
+	if err := c.Bind(&payload); err != nil {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: "Invalid request body",
+		})
+	}
+
+	if err := utils.Validate.Struct(payload); err != nil {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status: "fail",
+			Data:   utils.FormatValidationErrors(err),
+		})
+	}
+
+	ctx := c.Request().Context()
+	actor := c.Get("user").(db.User)
+
+	team, err := utils.Queries.GetTeamByTeamId(ctx, payload.TeamID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return c.JSON(http.StatusNotFound, &models.Response{
+				Status:  "fail",
+				Message: "Team not found",
+			})
+		}
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to fetch team",
+		})
+	}
+
+	teamID := uuid.NullUUID{UUID: team.ID, Valid: true}
+
+	emails, err := utils.Queries.GetTeamUsersEmails(ctx, teamID)
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to get team members",
+		})
+	}
+
+	tx, err := utils.DB.Begin(ctx)
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to start transaction",
+		})
+	}
+	defer tx.Rollback(ctx)
+	qtx := utils.Queries.WithTx(tx)
+
+	if err := setScoreAuditContext(ctx, qtx, actor, fmt.Sprintf("disbanded %s: %s", team.Name, payload.Reason)); err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to record score changes",
+		})
+	}
+
+	keys, err := clearTeamSubmissions(ctx, qtx, team.ID)
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to delete team submissions",
+		})
+	}
+
+	if err := qtx.ClearTeamMembers(ctx, teamID); err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to remove team members",
+		})
+	}
+
+	if err := qtx.DeleteTeam(ctx, team.ID); err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to delete team",
+		})
+	}
+
+	if err := recordAudit(ctx, qtx, actor, "team.disband", team.ID, uuid.NullUUID{},
+		fmt.Sprintf("disbanded %s: %s", team.Name, payload.Reason)); err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to record audit log",
+		})
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to disband team",
+		})
+	}
+
+	deleteStoredFiles(ctx, keys)
+
+	go utils.SendBulkEmail(emails, "Team Disbanded",
+		fmt.Sprintf("The organisers have disbanded the team <strong>%s</strong>. You are requested to join another team or create a new team to continue.", html.EscapeString(team.Name)))
+
+	return c.JSON(http.StatusOK, &models.Response{
+		Status:  "success",
+		Message: "Team disbanded successfully",
+	})
+}
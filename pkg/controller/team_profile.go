@@ -0,0 +1,325 @@
+package controller
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/CodeChefVIT/devsoc-be-24/pkg/db"
+	"github.com/CodeChefVIT/devsoc-be-24/pkg/dto"
+	logger "github.com/CodeChefVIT/devsoc-be-24/pkg/logging"
+	"github.com/CodeChefVIT/devsoc-be-24/pkg/models"
+	"github.com/CodeChefVIT/devsoc-be-24/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/labstack/echo/v4"
+)
+
+const maxAvatarSize = 2 << 20
+
+var avatarExtensions = map[string]string{
+	"image/png":  ".png",
+	"image/jpeg": ".jpg",
+	"image/webp": ".webp",
+}
+
+func avatarURL(teamId uuid.UUID, avatar string) string {
+	if avatar == "" {
+		return ""
+	}
+	return "/gallery/avatar/" + teamId.String()
+}
+
+func teamProfile(team db.Team) dto.TeamProfile {
+	return dto.TeamProfile{
+		ID:          team.ID.String(),
+		Name:        team.Name,
+		Tagline:     team.Tagline,
+		Description: team.Description,
+		AvatarURL:   avatarURL(team.ID, team.Avatar),
+		RepoLink:    team.RepoLink,
+		DemoLink:    team.DemoLink,
+	}
+}
+
+func UpdateTeamProfile(c echo.Context) error {
+	var payload models.UpdateTeamProfile
+
+	if err := c.Bind(&payload); err != nil {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: "Invalid request body",
+		})
+	}
+
+	if err := utils.Validate.Struct(payload); err != nil {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: utils.FormatValidationErrors(err),
+		})
+	}
+
+	user, ok := c.Get("user").(db.User)
+	if !ok || !user.TeamID.Valid {
+		return c.JSON(http.StatusForbidden, &models.Response{
+			Status:  "fail",
+			Message: "User does not belong to any team",
+		})
+	}
+
+	if !user.IsLeader {
+		return c.JSON(http.StatusForbidden, &models.Response{
+			Status:  "fail",
+			Message: "Only leaders can update team",
+		})
+	}
+
+	team, err := utils.Queries.UpdateTeamProfile(c.Request().Context(), db.UpdateTeamProfileParams{
+		ID:          user.TeamID.UUID,
+		Tagline:     payload.Tagline,
+		Description: payload.Description,
+		RepoLink:    payload.RepoLink,
+		DemoLink:    payload.DemoLink,
+	})
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to update team profile",
+		})
+	}
+
+	return c.JSON(http.StatusOK, &models.Response{
+		Status:  "success",
+		Message: "Team profile updated successfully",
+		Data:    teamProfile(team),
+	})
+}
+
+func UploadTeamAvatar(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	user, ok := c.Get("user").(db.User)
+	if !ok || !user.TeamID.Valid {
+		return c.JSON(http.StatusForbidden, &models.Response{
+			Status:  "fail",
+			Message: "User does not belong to any team",
+		})
+	}
+
+	if !user.IsLeader {
+		return c.JSON(http.StatusForbidden, &models.Response{
+			Status:  "fail",
+			Message: "Only leaders can update team",
+		})
+	}
+
+	file, err := c.FormFile("avatar")
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: "avatar file is required",
+		})
+	}
+
+	if file.Size > maxAvatarSize {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: "Avatar must be smaller than 2MB",
+		})
+	}
+
+	src, err := file.Open()
+	if err != nil {
+		logger.Errorf(logger.InternalError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to read avatar",
+		})
+	}
+	defer src.Close()
+
+	head := make([]byte, 512)
+	n, err := io.ReadFull(src, head)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: "Failed to read avatar",
+		})
+	}
+
+	ext, ok := avatarExtensions[http.DetectContentType(head[:n])]
+	if !ok {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: "Avatar must be a PNG, JPEG or WebP image",
+		})
+	}
+
+	if _, err := src.Seek(0, io.SeekStart); err != nil {
+		logger.Errorf(logger.InternalError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to read avatar",
+		})
+	}
+
+	team, err := utils.Queries.GetTeamByTeamId(ctx, user.TeamID.UUID)
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to fetch team",
+		})
+	}
+
+	dir := filepath.Join(utils.Config.UploadDir, "avatars")
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		logger.Errorf(logger.InternalError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to store avatar",
+		})
+	}
+
+	avatar := filepath.Join("avatars", team.ID.String()+ext)
+	dst, err := os.Create(filepath.Join(utils.Config.UploadDir, avatar))
+	if err != nil {
+		logger.Errorf(logger.InternalError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to store avatar",
+		})
+	}
+	defer dst.Close()
+
+	if _, err := io.Copy(dst, src); err != nil {
+		logger.Errorf(logger.InternalError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to store avatar",
+		})
+	}
+
+	if team.Avatar != "" && team.Avatar != avatar {
+		os.Remove(filepath.Join(utils.Config.UploadDir, team.Avatar))
+	}
+
+	if err := utils.Queries.UpdateTeamAvatar(ctx, db.UpdateTeamAvatarParams{
+		Avatar: avatar,
+		ID:     team.ID,
+	}); err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to update avatar",
+		})
+	}
+
+	return c.JSON(http.StatusOK, &models.Response{
+		Status:  "success",
+		Message: "Avatar updated successfully",
+		Data: map[string]string{
+			"avatar_url": avatarURL(team.ID, avatar),
+		},
+	})
+}
+
+func GetTeamAvatar(c echo.Context) error {
+	teamId, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: "Invalid team ID format",
+		})
+	}
+
+	team, err := utils.Queries.GetTeamByTeamId(c.Request().Context(), teamId)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return c.JSON(http.StatusNotFound, &models.Response{
+				Status:  "fail",
+				Message: "Team not found",
+			})
+		}
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to fetch team",
+		})
+	}
+
+	if team.Avatar == "" {
+		return c.JSON(http.StatusNotFound, &models.Response{
+			Status:  "fail",
+			Message: "Team has no avatar",
+		})
+	}
+
+	return c.File(filepath.Join(utils.Config.UploadDir, team.Avatar))
+}
+
+func GetProjectGallery(c echo.Context) error {
+	ctx := c.Request().Context()
+	limitParam := c.QueryParam("limit")
+	cursor := c.QueryParam("cursor")
+
+	limit, err := strconv.Atoi(limitParam)
+	if err != nil || limit <= 0 {
+		limit = 10
+	}
+
+	var cursorUUID uuid.UUID
+	if cursor != "" {
+		cursorUUID, err = uuid.Parse(cursor)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{
+				"error": "Invalid UUID for cursor",
+			})
+		}
+	}
+
+	rows, err := utils.Queries.GetProjectGallery(ctx, db.GetProjectGalleryParams{
+		ID:    cursorUUID,
+		Limit: int32(limit),
+	})
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to fetch projects",
+		})
+	}
+
+	projects := make([]dto.TeamProfile, 0, len(rows))
+	for _, row := range rows {
+		projects = append(projects, dto.TeamProfile{
+			ID:          row.ID.String(),
+			Name:        row.Name,
+			Tagline:     row.Tagline,
+			Description: row.Description,
+			AvatarURL:   avatarURL(row.ID, row.Avatar),
+			RepoLink:    row.RepoLink,
+			DemoLink:    row.DemoLink,
+			IdeaTitle:   getSafeString(row.Title),
+			Track:       getSafeString(row.Track),
+		})
+	}
+
+	var nextCursor uuid.NullUUID
+	if len(rows) > 0 {
+		nextCursor = uuid.NullUUID{UUID: rows[len(rows)-1].ID, Valid: true}
+	}
+
+	return c.JSON(http.StatusOK, &models.Response{
+		Status:  "success",
+		Message: "Projects fetched successfully",
+		Data: map[string]interface{}{
+			"projects":    projects,
+			"next_cursor": nextCursor,
+		},
+	})
+}
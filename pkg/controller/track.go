@@ -0,0 +1,218 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/CodeChefVIT/devsoc-be-24/pkg/db"
+	logger "github.com/CodeChefVIT/devsoc-be-24/pkg/logging"
+	"github.com/CodeChefVIT/devsoc-be-24/pkg/models"
+	"github.com/CodeChefVIT/devsoc-be-24/pkg/utils"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/labstack/echo/v4"
+)
+
+var (
+	errUnknownTrack = errors.New("unknown track")
+	errTrackFull    = errors.New("track is full")
+)
+
+// resolveTrack maps a user supplied track to its catalog entry.
+func resolveTrack(ctx context.Context, name string) (db.Track, error) {
+	track, err := utils.Queries.GetTrack(ctx, utils.TrackSlug(name))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return track, errUnknownTrack
+	}
+	return track, err
+}
+
+// checkTrackCapacity rejects new ideas once a track is full. A capacity of 0
+// means the track is unlimited.
+func checkTrackCapacity(ctx context.Context, track db.Track) error {
+	if track.Capacity == 0 {
+		return nil
+	}
+
+	count, err := utils.Queries.CountIdeasByTrack(ctx, track.Slug)
+	if err != nil {
+		return err
+	}
+	if count >= int64(track.Capacity) {
+		return errTrackFull
+	}
+	return nil
+}
+
+func trackError(c echo.Context, err error) error {
+	if errors.Is(err, errUnknownTrack) {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: "Unknown track, see /tracks for the list of tracks",
+		})
+	}
+	if errors.Is(err, errTrackFull) {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: "Track has reached its capacity",
+		})
+	}
+
+	logger.Errorf(logger.DatabaseError, err.Error())
+	return c.JSON(http.StatusInternalServerError, &models.Response{
+		Status:  "fail",
+		Message: "Failed to fetch track",
+	})
+}
+
+func GetTracks(c echo.Context) error {
+	tracks, err := utils.Queries.GetTracks(c.Request().Context())
+	if err != nil {
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to fetch tracks",
+		})
+	}
+
+	return c.JSON(http.StatusOK, &models.Response{
+		Status:  "success",
+		Message: "Tracks fetched successfully",
+		Data:    tracks,
+	})
+}
+
+func CreateTrack(c echo.Context) error {
+	var payload models.Track
+
+	if err := c.Bind(&payload); err != nil {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: "Invalid request body",
+		})
+	}
+
+	if err := utils.Validate.Struct(payload); err != nil {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: utils.FormatValidationErrors(err),
+		})
+	}
+
+	slug := payload.Slug
+	if slug == "" {
+		slug = payload.Name
+	}
+	slug = utils.TrackSlug(slug)
+	if slug == "" {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: "Track slug must contain letters or digits",
+		})
+	}
+
+	track, err := utils.Queries.CreateTrack(c.Request().Context(), db.CreateTrackParams{
+		Slug:           slug,
+		Name:           payload.Name,
+		Description:    payload.Description,
+		Capacity:       payload.Capacity,
+		Sponsor:        payload.Sponsor,
+		SelectionQuota: payload.SelectionQuota,
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return c.JSON(http.StatusConflict, &models.Response{
+				Status:  "fail",
+				Message: "A track with this name or slug already exists",
+			})
+		}
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to create track",
+		})
+	}
+
+	return c.JSON(http.StatusCreated, &models.Response{
+		Status:  "success",
+		Message: "Track created successfully",
+		Data:    track,
+	})
+}
+
+func UpdateTrack(c echo.Context) error {
+	var payload models.Track
+
+	if err := c.Bind(&payload); err != nil {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: "Invalid request body",
+		})
+	}
+
+	if err := utils.Validate.Struct(payload); err != nil {
+		return c.JSON(http.StatusBadRequest, &models.Response{
+			Status:  "fail",
+			Message: utils.FormatValidationErrors(err),
+		})
+	}
+
+	track, err := utils.Queries.UpdateTrack(c.Request().Context(), db.UpdateTrackParams{
+		Slug:           c.Param("slug"),
+		Name:           payload.Name,
+		Description:    payload.Description,
+		Capacity:       payload.Capacity,
+		Sponsor:        payload.Sponsor,
+		SelectionQuota: payload.SelectionQuota,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return c.JSON(http.StatusNotFound, &models.Response{
+				Status:  "fail",
+				Message: "Track not found",
+			})
+		}
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return c.JSON(http.StatusConflict, &models.Response{
+				Status:  "fail",
+				Message: "A track with this name already exists",
+			})
+		}
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to update track",
+		})
+	}
+
+	return c.JSON(http.StatusOK, &models.Response{
+		Status:  "success",
+		Message: "Track updated successfully",
+		Data:    track,
+	})
+}
+
+func DeleteTrack(c echo.Context) error {
+	if err := utils.Queries.DeleteTrack(c.Request().Context(), c.Param("slug")); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			return c.JSON(http.StatusConflict, &models.Response{
+				Status:  "fail",
+				Message: "Track is still used by ideas or submissions",
+			})
+		}
+		logger.Errorf(logger.DatabaseError, err.Error())
+		return c.JSON(http.StatusInternalServerError, &models.Response{
+			Status:  "fail",
+			Message: "Failed to delete track",
+		})
+	}
+
+	return c.JSON(http.StatusOK, &models.Response{
+		Status:  "success",
+		Message: "Track deleted successfully",
+	})
+}
@@ -17,7 +17,7 @@ INSERT INTO ideas (
 ) VALUES (
   $1, $2, $3, $4, $5, $6
 )
-RETURNING id, title, description, track, team_id, is_selected, created_at, updated_at
+RETURNING id, title, description, track, team_id, is_selected, created_at, updated_at, status, review_note, reviewed_by, reviewed_at
 `
 
 type CreateIdeaParams struct {
@@ -48,6 +48,10 @@ func (q *Queries) CreateIdea(ctx context.Context, arg CreateIdeaParams) (Idea, e
 		&i.IsSelected,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Status,
+		&i.ReviewNote,
+		&i.ReviewedBy,
+		&i.ReviewedAt,
 	)
 	return i, err
 }
@@ -62,8 +66,18 @@ func (q *Queries) DeleteIdea(ctx context.Context, id uuid.UUID) error {
 	return err
 }
 
+const deleteIdeaByTeamID = `-- name: DeleteIdeaByTeamID :exec
+DELETE FROM ideas
+WHERE team_id = $1
+`
+
+func (q *Queries) DeleteIdeaByTeamID(ctx context.Context, teamID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteIdeaByTeamID, teamID)
+	return err
+}
+
 const getAllIdeas = `-- name: GetAllIdeas :many
-SELECT id, title, description, track, team_id, is_selected, created_at, updated_at FROM ideas
+SELECT id, title, description, track, team_id, is_selected, created_at, updated_at, status, review_note, reviewed_by, reviewed_at FROM ideas
 WHERE id > $1
 ORDER BY id
 LIMIT $2
@@ -92,6 +106,10 @@ func (q *Queries) GetAllIdeas(ctx context.Context, arg GetAllIdeasParams) ([]Ide
 			&i.IsSelected,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Status,
+			&i.ReviewNote,
+			&i.ReviewedBy,
+			&i.ReviewedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -104,7 +122,7 @@ func (q *Queries) GetAllIdeas(ctx context.Context, arg GetAllIdeasParams) ([]Ide
 }
 
 const getIdea = `-- name: GetIdea :one
-SELECT id, title, description, track, team_id, is_selected, created_at, updated_at FROM ideas
+SELECT id, title, description, track, team_id, is_selected, created_at, updated_at, status, review_note, reviewed_by, reviewed_at FROM ideas
 WHERE id = $1 LIMIT 1
 `
 
@@ -120,12 +138,16 @@ func (q *Queries) GetIdea(ctx context.Context, id uuid.UUID) (Idea, error) {
 		&i.IsSelected,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Status,
+		&i.ReviewNote,
+		&i.ReviewedBy,
+		&i.ReviewedAt,
 	)
 	return i, err
 }
 
 const getIdeaByTeamID = `-- name: GetIdeaByTeamID :one
-SELECT id, title, description, track
+SELECT id, title, description, track, status, review_note
 FROM ideas
 WHERE team_id = $1
 LIMIT 1
@@ -136,6 +158,8 @@ type GetIdeaByTeamIDRow struct {
 	Title       string
 	Description string
 	Track       string
+	Status      string
+	ReviewNote  string
 }
 
 func (q *Queries) GetIdeaByTeamID(ctx context.Context, teamID uuid.UUID) (GetIdeaByTeamIDRow, error) {
@@ -146,14 +170,16 @@ func (q *Queries) GetIdeaByTeamID(ctx context.Context, teamID uuid.UUID) (GetIde
 		&i.Title,
 		&i.Description,
 		&i.Track,
+		&i.Status,
+		&i.ReviewNote,
 	)
 	return i, err
 }
 
 const getIdeasByTrack = `-- name: GetIdeasByTrack :many
-SELECT id, title, description, track, team_id, is_selected, created_at, updated_at FROM ideas
+SELECT id, title, description, track, team_id, is_selected, created_at, updated_at, status, review_note, reviewed_by, reviewed_at FROM ideas
 WHERE 
-    (title ilike '%'||$1||'%' OR track ilike '%'||$1||'%')
+    (title ilike '%'||$1||'%' OR track ilike '%'||$1||'%' OR track = $4::TEXT)
     AND id > $2::UUID  -- Explicit UUID comparison
 ORDER BY id
 LIMIT $3::INTEGER
@@ -163,10 +189,16 @@ type GetIdeasByTrackParams struct {
 	Column1 *string
 	Column2 uuid.UUID
 	Column3 int32
+	Column4 string
 }
 
 func (q *Queries) GetIdeasByTrack(ctx context.Context, arg GetIdeasByTrackParams) ([]Idea, error) {
-	rows, err := q.db.Query(ctx, getIdeasByTrack, arg.Column1, arg.Column2, arg.Column3)
+	rows, err := q.db.Query(ctx, getIdeasByTrack,
+		arg.Column1,
+		arg.Column2,
+		arg.Column3,
+		arg.Column4,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -183,6 +215,10 @@ func (q *Queries) GetIdeasByTrack(ctx context.Context, arg GetIdeasByTrackParams
 			&i.IsSelected,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Status,
+			&i.ReviewNote,
+			&i.ReviewedBy,
+			&i.ReviewedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -195,7 +231,7 @@ func (q *Queries) GetIdeasByTrack(ctx context.Context, arg GetIdeasByTrackParams
 }
 
 const listIdeas = `-- name: ListIdeas :many
-SELECT id, title, description, track, team_id, is_selected, created_at, updated_at FROM ideas
+SELECT id, title, description, track, team_id, is_selected, created_at, updated_at, status, review_note, reviewed_by, reviewed_at FROM ideas
 ORDER BY created_at DESC
 `
 
@@ -217,6 +253,65 @@ func (q *Queries) ListIdeas(ctx context.Context) ([]Idea, error) {
 			&i.IsSelected,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Status,
+			&i.ReviewNote,
+			&i.ReviewedBy,
+			&i.ReviewedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const reviewIdeas = `-- name: ReviewIdeas :many
+UPDATE ideas
+SET status = $1,
+    is_selected = ($1::TEXT = 'selected'),
+    review_note = $2,
+    reviewed_by = $3,
+    reviewed_at = CURRENT_TIMESTAMP
+WHERE id = ANY($4::UUID[])
+RETURNING id, team_id, title, track
+`
+
+type ReviewIdeasParams struct {
+	Status     string
+	ReviewNote string
+	ReviewedBy uuid.NullUUID
+	Ids        []uuid.UUID
+}
+
+type ReviewIdeasRow struct {
+	ID     uuid.UUID
+	TeamID uuid.UUID
+	Title  string
+	Track  string
+}
+
+func (q *Queries) ReviewIdeas(ctx context.Context, arg ReviewIdeasParams) ([]ReviewIdeasRow, error) {
+	rows, err := q.db.Query(ctx, reviewIdeas,
+		arg.Status,
+		arg.ReviewNote,
+		arg.ReviewedBy,
+		arg.Ids,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ReviewIdeasRow
+	for rows.Next() {
+		var i ReviewIdeasRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.TeamID,
+			&i.Title,
+			&i.Track,
 		); err != nil {
 			return nil, err
 		}
@@ -228,6 +323,23 @@ func (q *Queries) ListIdeas(ctx context.Context) ([]Idea, error) {
 	return items, nil
 }
 
+const transferIdea = `-- name: TransferIdea :exec
+UPDATE ideas
+SET team_id = $1,
+    updated_at = CURRENT_TIMESTAMP
+WHERE team_id = $2
+`
+
+type TransferIdeaParams struct {
+	TargetTeamID uuid.UUID
+	SourceTeamID uuid.UUID
+}
+
+func (q *Queries) TransferIdea(ctx context.Context, arg TransferIdeaParams) error {
+	_, err := q.db.Exec(ctx, transferIdea, arg.TargetTeamID, arg.SourceTeamID)
+	return err
+}
+
 const updateIdea = `-- name: UpdateIdea :exec
 UPDATE ideas
 SET title = $2,
@@ -9,6 +9,16 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type AuditLog struct {
+	ID        uuid.UUID
+	ActorID   uuid.UUID
+	Action    string
+	TeamID    uuid.NullUUID
+	TargetID  uuid.NullUUID
+	Details   string
+	CreatedAt pgtype.Timestamp
+}
+
 type Idea struct {
 	ID          uuid.UUID
 	Title       string
@@ -18,18 +28,127 @@ type Idea struct {
 	IsSelected  bool
 	CreatedAt   pgtype.Timestamp
 	UpdatedAt   pgtype.Timestamp
+	Status      string
+	ReviewNote  string
+	ReviewedBy  uuid.NullUUID
+	ReviewedAt  pgtype.Timestamp
 }
 
-type Score struct {
-	ID             uuid.UUID
-	TeamID         uuid.UUID
-	Design         int32
-	Implementation int32
-	Presentation   int32
+type IdeaComment struct {
+	ID        uuid.UUID
+	IdeaID    uuid.UUID
+	AuthorID  uuid.NullUUID
+	Body      string
+	Internal  bool
+	CreatedAt pgtype.Timestamp
+}
+
+type IdeaRevision struct {
+	ID          uuid.UUID
+	IdeaID      uuid.UUID
+	Revision    int32
+	Title       string
+	Description string
+	Track       string
+	AuthorID    uuid.NullUUID
+	CreatedAt   pgtype.Timestamp
+	Round       int32
+}
+
+type JudgingRound struct {
 	Round          int32
-	Innovation     int32
-	Teamwork       int32
-	Comment        *string
+	Normalisation  string
+	UpdatedAt      pgtype.Timestamp
+	State          string
+	FinalisedAt    pgtype.Timestamp
+	PublishedAt    pgtype.Timestamp
+	ReopenReason   *string
+	StateChangedBy uuid.NullUUID
+}
+
+type NormalisedScore struct {
+	ID            uuid.UUID
+	TeamID        uuid.UUID
+	Round         int32
+	PanelID       uuid.NullUUID
+	Total         float64
+	Normalisation string
+	Normalised    float64
+}
+
+type PanelAssignment struct {
+	ID         uuid.UUID
+	Round      int32
+	PanelID    uuid.UUID
+	TeamID     uuid.UUID
+	AssignedBy uuid.UUID
+	CreatedAt  pgtype.Timestamp
+	IsAuto     bool
+}
+
+type PanelConflict struct {
+	ID        uuid.UUID
+	PanelID   uuid.UUID
+	TeamID    uuid.NullUUID
+	UserID    uuid.NullUUID
+	Reason    string
+	CreatedAt pgtype.Timestamp
+}
+
+type Phase struct {
+	Name         string
+	OpensAt      pgtype.Timestamptz
+	ClosesAt     pgtype.Timestamptz
+	GraceMinutes int32
+}
+
+type PhaseOverride struct {
+	ID        uuid.UUID
+	Phase     string
+	TeamID    uuid.UUID
+	ClosesAt  pgtype.Timestamptz
+	GrantedBy uuid.UUID
+	CreatedAt pgtype.Timestamp
+}
+
+type RubricCriterion struct {
+	ID          uuid.UUID
+	Round       int32
+	Slug        string
+	Name        string
+	Description string
+	Weight      float64
+	MaxPoints   int32
+	Position    int32
+	CreatedAt   pgtype.Timestamp
+}
+
+type Score struct {
+	ID      uuid.UUID
+	TeamID  uuid.UUID
+	Round   int32
+	Comment *string
+	PanelID uuid.NullUUID
+}
+
+type ScoreAudit struct {
+	ID        uuid.UUID
+	ScoreID   uuid.UUID
+	TeamID    uuid.UUID
+	Round     int32
+	PanelID   uuid.NullUUID
+	ActorID   uuid.NullUUID
+	Action    string
+	Before    []byte
+	After     []byte
+	Reason    string
+	CreatedAt pgtype.Timestamp
+}
+
+type ScoreCriterion struct {
+	ScoreID     uuid.UUID
+	CriterionID uuid.UUID
+	Points      int32
 }
 
 type Submission struct {
@@ -41,6 +160,58 @@ type Submission struct {
 	FigmaLink   string
 	OtherLink   string
 	TeamID      uuid.UUID
+	IsLate      bool
+	UpdatedAt   pgtype.Timestamp
+	Round       int32
+}
+
+type SubmissionAttachment struct {
+	ID          uuid.UUID
+	TeamID      uuid.UUID
+	FileName    string
+	ContentType string
+	Size        int64
+	StorageKey  string
+	UploadedBy  uuid.NullUUID
+	CreatedAt   pgtype.Timestamp
+	Round       int32
+}
+
+type SubmissionRequirement struct {
+	Round          int32
+	RequiredFields []string
+	UpdatedAt      pgtype.Timestamp
+}
+
+type SubmissionSnapshot struct {
+	ID          uuid.UUID
+	TeamID      uuid.UUID
+	Round       int32
+	Version     int32
+	Title       string
+	Description string
+	Track       string
+	GithubLink  string
+	FigmaLink   string
+	OtherLink   string
+	IsLate      bool
+	FrozenBy    uuid.UUID
+	FrozenAt    pgtype.Timestamp
+}
+
+type SubmissionVersion struct {
+	ID          uuid.UUID
+	TeamID      uuid.UUID
+	Version     int32
+	Title       string
+	Description string
+	Track       string
+	GithubLink  string
+	FigmaLink   string
+	OtherLink   string
+	IsLate      bool
+	AuthorID    uuid.NullUUID
+	CreatedAt   pgtype.Timestamp
 }
 
 type Team struct {
@@ -50,6 +221,33 @@ type Team struct {
 	RoundQualified pgtype.Int4
 	Code           string
 	IsBanned       bool
+	Tagline        string
+	Description    string
+	Avatar         string
+	RepoLink       string
+	DemoLink       string
+}
+
+type TeamNameFlag struct {
+	ID         uuid.UUID
+	TeamID     uuid.UUID
+	Name       string
+	Reason     string
+	Status     string
+	ReviewedBy uuid.NullUUID
+	CreatedAt  pgtype.Timestamp
+	ReviewedAt pgtype.Timestamp
+}
+
+type Track struct {
+	Slug           string
+	Name           string
+	Description    string
+	Capacity       int32
+	Sponsor        string
+	CreatedAt      pgtype.Timestamp
+	UpdatedAt      pgtype.Timestamp
+	SelectionQuota int32
 }
 
 type User struct {
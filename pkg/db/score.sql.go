@@ -9,40 +9,49 @@ import (
 	"context"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 const createScore = `-- name: CreateScore :exec
-INSERT INTO score (id, team_id, design, implementation, presentation, round,  innovation, teamwork, comment)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+INSERT INTO score (id, team_id, round, comment, panel_id)
+VALUES ($1, $2, $3, $4, $5)
 `
 
 type CreateScoreParams struct {
-	ID             uuid.UUID
-	TeamID         uuid.UUID
-	Design         int32
-	Implementation int32
-	Presentation   int32
-	Round          int32
-	Innovation     int32
-	Teamwork       int32
-	Comment        *string
+	ID      uuid.UUID
+	TeamID  uuid.UUID
+	Round   int32
+	Comment *string
+	PanelID uuid.NullUUID
 }
 
 func (q *Queries) CreateScore(ctx context.Context, arg CreateScoreParams) error {
 	_, err := q.db.Exec(ctx, createScore,
 		arg.ID,
 		arg.TeamID,
-		arg.Design,
-		arg.Implementation,
-		arg.Presentation,
 		arg.Round,
-		arg.Innovation,
-		arg.Teamwork,
 		arg.Comment,
+		arg.PanelID,
 	)
 	return err
 }
 
+const createScoreCriterion = `-- name: CreateScoreCriterion :exec
+INSERT INTO score_criteria (score_id, criterion_id, points)
+VALUES ($1, $2, $3)
+`
+
+type CreateScoreCriterionParams struct {
+	ScoreID     uuid.UUID
+	CriterionID uuid.UUID
+	Points      int32
+}
+
+func (q *Queries) CreateScoreCriterion(ctx context.Context, arg CreateScoreCriterionParams) error {
+	_, err := q.db.Exec(ctx, createScoreCriterion, arg.ScoreID, arg.CriterionID, arg.Points)
+	return err
+}
+
 const deleteScore = `-- name: DeleteScore :exec
 DELETE FROM score
 WHERE id = $1
@@ -53,89 +62,354 @@ func (q *Queries) DeleteScore(ctx context.Context, id uuid.UUID) error {
 	return err
 }
 
-const getLeaderboardWithPagination = `-- name: GetLeaderboardWithPagination :many
+const deleteScoreCriteria = `-- name: DeleteScoreCriteria :exec
+DELETE FROM score_criteria
+WHERE score_id = $1
+`
+
+func (q *Queries) DeleteScoreCriteria(ctx context.Context, scoreID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteScoreCriteria, scoreID)
+	return err
+}
+
+const deleteTeamScores = `-- name: DeleteTeamScores :exec
+DELETE FROM score
+WHERE team_id = $1
+`
+
+func (q *Queries) DeleteTeamScores(ctx context.Context, teamID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteTeamScores, teamID)
+	return err
+}
+
+const getLeaderboard = `-- name: GetLeaderboard :many
 WITH RoundScores AS (
-    SELECT 
-        s.team_id,
-        t.name,
-        s.round,
-        s.design,
-        s.implementation,
-        s.presentation,
-        s.innovation,
-        s.teamwork,
-        (s.design + s.implementation + s.presentation + s.innovation + s.teamwork) AS round_total
+    SELECT
+        team_id,
+        round,
+        (CASE WHEN $1::TEXT = 'median'
+            THEN PERCENTILE_CONT(0.5) WITHIN GROUP (ORDER BY total)
+            ELSE AVG(total)
+        END)::FLOAT8 AS round_total,
+        (CASE WHEN $1::TEXT = 'median'
+            THEN PERCENTILE_CONT(0.5) WITHIN GROUP (ORDER BY normalised)
+            ELSE AVG(normalised)
+        END)::FLOAT8 AS normalised_total
+    FROM normalised_scores
+    WHERE $2::INTEGER = 0 OR round = $2::INTEGER
+    GROUP BY team_id, round
+),
+Innovation AS (
+    SELECT s.team_id, AVG(sc.points)::FLOAT8 AS innovation
     FROM score s
-    JOIN teams t ON s.team_id = t.id
+    JOIN score_criteria sc ON sc.score_id = s.id
+    JOIN rubric_criteria c ON c.id = sc.criterion_id
+    WHERE c.slug = 'innovation'
+      AND ($2::INTEGER = 0 OR s.round = $2::INTEGER)
+    GROUP BY s.team_id
+),
+TeamTracks AS (
+    SELECT t.id AS team_id,
+        COALESCE(
+            (SELECT s.track FROM submission s WHERE s.team_id = t.id AND s.track <> '' ORDER BY s.round DESC LIMIT 1),
+            (SELECT i.track FROM ideas i WHERE i.team_id = t.id LIMIT 1),
+            ''
+        )::TEXT AS track
+    FROM teams t
+),
+TeamScores AS (
+    SELECT
+        team_id,
+        SUM(round_total)::FLOAT8 AS overall_total,
+        SUM(normalised_total)::FLOAT8 AS overall_normalised,
+        COALESCE(SUM(normalised_total) FILTER (WHERE round = (SELECT MAX(round) FROM RoundScores)), 0)::FLOAT8 AS latest_round_total
+    FROM RoundScores
+    GROUP BY team_id
 ),
-TotalScores AS (
-    SELECT 
-        s.team_id,
+Ranked AS (
+    SELECT
+        ts.team_id,
         t.name,
-        SUM(s.design + s.implementation + s.presentation + s.innovation + s.teamwork) AS overall_total
-    FROM score s
-    JOIN teams t ON s.team_id = t.id
-    GROUP BY s.team_id, t.name
+        tt.track,
+        ts.overall_total,
+        ts.overall_normalised,
+        ts.latest_round_total,
+        COALESCE(i.innovation, 0)::FLOAT8 AS innovation,
+        RANK() OVER w AS competition_rank,
+        DENSE_RANK() OVER w AS dense_rank
+    FROM TeamScores ts
+    JOIN teams t ON t.id = ts.team_id
+    JOIN TeamTracks tt ON tt.team_id = ts.team_id
+    LEFT JOIN Innovation i ON i.team_id = ts.team_id
+    WHERE ($3::TEXT = '' OR tt.track = $3::TEXT)
+      AND ($4::INTEGER = 0 OR GREATEST(COALESCE(t.round_qualified, 0), 1) >= $4::INTEGER)
+    WINDOW w AS (ORDER BY ts.overall_normalised DESC, ts.latest_round_total DESC, COALESCE(i.innovation, 0) DESC)
 )
-SELECT 
-    rs.team_id,
-    rs.name,
-    rs.round,
-    rs.design,
-    rs.implementation,
-    rs.presentation,
-    rs.innovation,
-    rs.teamwork,
-    rs.round_total,
-    ts.overall_total
-FROM RoundScores rs
-JOIN TotalScores ts ON rs.team_id = ts.team_id
-WHERE 
-    ($1::UUID IS NULL OR rs.team_id > $1)  -- Cursor-based pagination
-    AND ($2::TEXT IS NULL OR rs.name ILIKE '%' || $2 || '%') -- Optional name filter
-ORDER BY ts.overall_total DESC, rs.round ASC
-LIMIT $3
+SELECT
+    team_id,
+    name,
+    track,
+    overall_total,
+    overall_normalised,
+    latest_round_total,
+    innovation,
+    competition_rank::INTEGER AS competition_rank,
+    dense_rank::INTEGER AS dense_rank
+FROM Ranked
+WHERE ($5::TEXT = '' OR name ILIKE '%' || $5 || '%')
+    AND (NOT $6::BOOLEAN
+        OR (overall_normalised, latest_round_total, innovation, team_id)
+            < ($7::FLOAT8, $8::FLOAT8, $9::FLOAT8, $10::UUID))
+ORDER BY overall_normalised DESC, latest_round_total DESC, innovation DESC, team_id DESC
+LIMIT $11
 `
 
-type GetLeaderboardWithPaginationParams struct {
-	Column1 uuid.UUID
-	Column2 string
-	Limit   int32
+type GetLeaderboardParams struct {
+	Aggregate        string
+	Round            int32
+	Track            string
+	Qualified        int32
+	Name             string
+	HasCursor        bool
+	CursorTotal      float64
+	CursorLatest     float64
+	CursorInnovation float64
+	CursorTeam       uuid.UUID
+	RowLimit         int32
 }
 
-type GetLeaderboardWithPaginationRow struct {
-	TeamID         uuid.UUID
-	Name           string
-	Round          int32
-	Design         int32
-	Implementation int32
-	Presentation   int32
-	Innovation     int32
-	Teamwork       int32
-	RoundTotal     int32
-	OverallTotal   int64
+type GetLeaderboardRow struct {
+	TeamID            uuid.UUID
+	Name              string
+	Track             string
+	OverallTotal      float64
+	OverallNormalised float64
+	LatestRoundTotal  float64
+	Innovation        float64
+	CompetitionRank   int32
+	DenseRank         int32
 }
 
-func (q *Queries) GetLeaderboardWithPagination(ctx context.Context, arg GetLeaderboardWithPaginationParams) ([]GetLeaderboardWithPaginationRow, error) {
-	rows, err := q.db.Query(ctx, getLeaderboardWithPagination, arg.Column1, arg.Column2, arg.Limit)
+func (q *Queries) GetLeaderboard(ctx context.Context, arg GetLeaderboardParams) ([]GetLeaderboardRow, error) {
+	rows, err := q.db.Query(ctx, getLeaderboard,
+		arg.Aggregate,
+		arg.Round,
+		arg.Track,
+		arg.Qualified,
+		arg.Name,
+		arg.HasCursor,
+		arg.CursorTotal,
+		arg.CursorLatest,
+		arg.CursorInnovation,
+		arg.CursorTeam,
+		arg.RowLimit,
+	)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []GetLeaderboardWithPaginationRow
+	var items []GetLeaderboardRow
 	for rows.Next() {
-		var i GetLeaderboardWithPaginationRow
+		var i GetLeaderboardRow
 		if err := rows.Scan(
 			&i.TeamID,
 			&i.Name,
-			&i.Round,
-			&i.Design,
-			&i.Implementation,
-			&i.Presentation,
+			&i.Track,
+			&i.OverallTotal,
+			&i.OverallNormalised,
+			&i.LatestRoundTotal,
 			&i.Innovation,
-			&i.Teamwork,
+			&i.CompetitionRank,
+			&i.DenseRank,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getLeaderboardCriteria = `-- name: GetLeaderboardCriteria :many
+SELECT s.team_id, s.round, c.slug,
+    (CASE WHEN $1::TEXT = 'median'
+        THEN PERCENTILE_CONT(0.5) WITHIN GROUP (ORDER BY sc.points)
+        ELSE AVG(sc.points)
+    END)::FLOAT8 AS points
+FROM score s
+JOIN score_criteria sc ON sc.score_id = s.id
+JOIN rubric_criteria c ON c.id = sc.criterion_id
+WHERE s.team_id = ANY($2::UUID[])
+  AND ($3::INTEGER = 0 OR s.round = $3::INTEGER)
+GROUP BY s.team_id, s.round, c.slug, c.position
+ORDER BY s.team_id, s.round, c.position
+`
+
+type GetLeaderboardCriteriaParams struct {
+	Aggregate string
+	TeamIds   []uuid.UUID
+	Round     int32
+}
+
+type GetLeaderboardCriteriaRow struct {
+	TeamID uuid.UUID
+	Round  int32
+	Slug   string
+	Points float64
+}
+
+func (q *Queries) GetLeaderboardCriteria(ctx context.Context, arg GetLeaderboardCriteriaParams) ([]GetLeaderboardCriteriaRow, error) {
+	rows, err := q.db.Query(ctx, getLeaderboardCriteria, arg.Aggregate, arg.TeamIds, arg.Round)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetLeaderboardCriteriaRow
+	for rows.Next() {
+		var i GetLeaderboardCriteriaRow
+		if err := rows.Scan(
+			&i.TeamID,
+			&i.Round,
+			&i.Slug,
+			&i.Points,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getLeaderboardRounds = `-- name: GetLeaderboardRounds :many
+SELECT
+    n.team_id,
+    n.round,
+    MIN(n.normalisation)::TEXT AS normalisation,
+    (CASE WHEN $1::TEXT = 'median'
+        THEN PERCENTILE_CONT(0.5) WITHIN GROUP (ORDER BY n.total)
+        ELSE AVG(n.total)
+    END)::FLOAT8 AS round_total,
+    (CASE WHEN $1::TEXT = 'median'
+        THEN PERCENTILE_CONT(0.5) WITHIN GROUP (ORDER BY n.normalised)
+        ELSE AVG(n.normalised)
+    END)::FLOAT8 AS normalised_total,
+    COUNT(*)::INTEGER AS judges,
+    COALESCE(jr.state, 'open')::TEXT AS state,
+    jr.finalised_at
+FROM normalised_scores n
+LEFT JOIN judging_rounds jr ON jr.round = n.round
+WHERE n.team_id = ANY($2::UUID[])
+  AND ($3::INTEGER = 0 OR n.round = $3::INTEGER)
+GROUP BY n.team_id, n.round, jr.state, jr.finalised_at
+ORDER BY n.team_id, n.round
+`
+
+type GetLeaderboardRoundsParams struct {
+	Aggregate string
+	TeamIds   []uuid.UUID
+	Round     int32
+}
+
+type GetLeaderboardRoundsRow struct {
+	TeamID          uuid.UUID
+	Round           int32
+	Normalisation   string
+	RoundTotal      float64
+	NormalisedTotal float64
+	Judges          int32
+	State           string
+	FinalisedAt     pgtype.Timestamp
+}
+
+func (q *Queries) GetLeaderboardRounds(ctx context.Context, arg GetLeaderboardRoundsParams) ([]GetLeaderboardRoundsRow, error) {
+	rows, err := q.db.Query(ctx, getLeaderboardRounds, arg.Aggregate, arg.TeamIds, arg.Round)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetLeaderboardRoundsRow
+	for rows.Next() {
+		var i GetLeaderboardRoundsRow
+		if err := rows.Scan(
+			&i.TeamID,
+			&i.Round,
+			&i.Normalisation,
 			&i.RoundTotal,
-			&i.OverallTotal,
+			&i.NormalisedTotal,
+			&i.Judges,
+			&i.State,
+			&i.FinalisedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getScoreByID = `-- name: GetScoreByID :one
+SELECT id, team_id, round, comment, panel_id FROM score
+WHERE id = $1
+`
+
+func (q *Queries) GetScoreByID(ctx context.Context, id uuid.UUID) (Score, error) {
+	row := q.db.QueryRow(ctx, getScoreByID, id)
+	var i Score
+	err := row.Scan(
+		&i.ID,
+		&i.TeamID,
+		&i.Round,
+		&i.Comment,
+		&i.PanelID,
+	)
+	return i, err
+}
+
+const getScoreExport = `-- name: GetScoreExport :many
+SELECT n.id, n.team_id, t.name, n.round, u.email AS judge, n.total, n.normalisation, n.normalised
+FROM normalised_scores n
+JOIN teams t ON t.id = n.team_id
+LEFT JOIN users u ON u.id = n.panel_id
+ORDER BY n.round, t.name, u.email
+`
+
+type GetScoreExportRow struct {
+	ID            uuid.UUID
+	TeamID        uuid.UUID
+	Name          string
+	Round         int32
+	Judge         *string
+	Total         float64
+	Normalisation string
+	Normalised    float64
+}
+
+func (q *Queries) GetScoreExport(ctx context.Context) ([]GetScoreExportRow, error) {
+	rows, err := q.db.Query(ctx, getScoreExport)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetScoreExportRow
+	for rows.Next() {
+		var i GetScoreExportRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.TeamID,
+			&i.Name,
+			&i.Round,
+			&i.Judge,
+			&i.Total,
+			&i.Normalisation,
+			&i.Normalised,
 		); err != nil {
 			return nil, err
 		}
@@ -147,31 +421,80 @@ func (q *Queries) GetLeaderboardWithPagination(ctx context.Context, arg GetLeade
 	return items, nil
 }
 
+const getTeamScoreCriteria = `-- name: GetTeamScoreCriteria :many
+SELECT sc.score_id, c.slug, sc.points
+FROM score_criteria sc
+JOIN score s ON s.id = sc.score_id
+JOIN rubric_criteria c ON c.id = sc.criterion_id
+WHERE s.team_id = $1
+ORDER BY c.position, c.slug
+`
+
+type GetTeamScoreCriteriaRow struct {
+	ScoreID uuid.UUID
+	Slug    string
+	Points  int32
+}
+
+func (q *Queries) GetTeamScoreCriteria(ctx context.Context, teamID uuid.UUID) ([]GetTeamScoreCriteriaRow, error) {
+	rows, err := q.db.Query(ctx, getTeamScoreCriteria, teamID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetTeamScoreCriteriaRow
+	for rows.Next() {
+		var i GetTeamScoreCriteriaRow
+		if err := rows.Scan(&i.ScoreID, &i.Slug, &i.Points); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getTeamScores = `-- name: GetTeamScores :many
-SELECT id, team_id, design, implementation, presentation, round, innovation, teamwork, comment
-FROM score
-WHERE team_id = $1
+SELECT s.id, s.team_id, s.round, s.comment, s.panel_id, u.email AS judge,
+    COALESCE(SUM(sc.points * c.weight), 0)::FLOAT8 AS total
+FROM score s
+LEFT JOIN users u ON u.id = s.panel_id
+LEFT JOIN score_criteria sc ON sc.score_id = s.id
+LEFT JOIN rubric_criteria c ON c.id = sc.criterion_id
+WHERE s.team_id = $1
+GROUP BY s.id, u.email
+ORDER BY s.round, u.email
 `
 
-func (q *Queries) GetTeamScores(ctx context.Context, teamID uuid.UUID) ([]Score, error) {
+type GetTeamScoresRow struct {
+	ID      uuid.UUID
+	TeamID  uuid.UUID
+	Round   int32
+	Comment *string
+	PanelID uuid.NullUUID
+	Judge   *string
+	Total   float64
+}
+
+func (q *Queries) GetTeamScores(ctx context.Context, teamID uuid.UUID) ([]GetTeamScoresRow, error) {
 	rows, err := q.db.Query(ctx, getTeamScores, teamID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []Score
+	var items []GetTeamScoresRow
 	for rows.Next() {
-		var i Score
+		var i GetTeamScoresRow
 		if err := rows.Scan(
 			&i.ID,
 			&i.TeamID,
-			&i.Design,
-			&i.Implementation,
-			&i.Presentation,
 			&i.Round,
-			&i.Innovation,
-			&i.Teamwork,
 			&i.Comment,
+			&i.PanelID,
+			&i.Judge,
+			&i.Total,
 		); err != nil {
 			return nil, err
 		}
@@ -183,33 +506,39 @@ func (q *Queries) GetTeamScores(ctx context.Context, teamID uuid.UUID) ([]Score,
 	return items, nil
 }
 
+const transferScores = `-- name: TransferScores :exec
+UPDATE score
+SET team_id = $1
+WHERE team_id = $2
+`
+
+type TransferScoresParams struct {
+	TargetTeamID uuid.UUID
+	SourceTeamID uuid.UUID
+}
+
+func (q *Queries) TransferScores(ctx context.Context, arg TransferScoresParams) error {
+	_, err := q.db.Exec(ctx, transferScores, arg.TargetTeamID, arg.SourceTeamID)
+	return err
+}
+
 const updateScore = `-- name: UpdateScore :exec
 UPDATE score
-SET team_id = $1, design = $2, implementation = $3, presentation = $4, round = $5, innovation = $6, teamwork = $7, comment = $8
-WHERE id = $9
+SET team_id = $1, round = $2, comment = $3
+WHERE id = $4
 `
 
 type UpdateScoreParams struct {
-	TeamID         uuid.UUID
-	Design         int32
-	Implementation int32
-	Presentation   int32
-	Round          int32
-	Innovation     int32
-	Teamwork       int32
-	Comment        *string
-	ID             uuid.UUID
+	TeamID  uuid.UUID
+	Round   int32
+	Comment *string
+	ID      uuid.UUID
 }
 
 func (q *Queries) UpdateScore(ctx context.Context, arg UpdateScoreParams) error {
 	_, err := q.db.Exec(ctx, updateScore,
 		arg.TeamID,
-		arg.Design,
-		arg.Implementation,
-		arg.Presentation,
 		arg.Round,
-		arg.Innovation,
-		arg.Teamwork,
 		arg.Comment,
 		arg.ID,
 	)
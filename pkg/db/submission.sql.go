@@ -20,8 +20,10 @@ INSERT INTO submission (
     track,
     github_link,
     figma_link,
-    other_link
-) VALUES ($1, $2, $3, $4, $5, $6, $7, $8) RETURNING id, title, description, track, github_link, figma_link, other_link, team_id
+    other_link,
+    is_late,
+    round
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10) RETURNING id, title, description, track, github_link, figma_link, other_link, team_id, is_late, updated_at, round
 `
 
 type CreateSubmissionParams struct {
@@ -33,6 +35,8 @@ type CreateSubmissionParams struct {
 	GithubLink  string
 	FigmaLink   string
 	OtherLink   string
+	IsLate      bool
+	Round       int32
 }
 
 func (q *Queries) CreateSubmission(ctx context.Context, arg CreateSubmissionParams) (Submission, error) {
@@ -45,6 +49,8 @@ func (q *Queries) CreateSubmission(ctx context.Context, arg CreateSubmissionPara
 		arg.GithubLink,
 		arg.FigmaLink,
 		arg.OtherLink,
+		arg.IsLate,
+		arg.Round,
 	)
 	var i Submission
 	err := row.Scan(
@@ -56,21 +62,70 @@ func (q *Queries) CreateSubmission(ctx context.Context, arg CreateSubmissionPara
 		&i.FigmaLink,
 		&i.OtherLink,
 		&i.TeamID,
+		&i.IsLate,
+		&i.UpdatedAt,
+		&i.Round,
 	)
 	return i, err
 }
 
 const deleteSubmission = `-- name: DeleteSubmission :exec
+DELETE FROM submission WHERE team_id = $1 AND round = $2
+`
+
+type DeleteSubmissionParams struct {
+	TeamID uuid.UUID
+	Round  int32
+}
+
+func (q *Queries) DeleteSubmission(ctx context.Context, arg DeleteSubmissionParams) error {
+	_, err := q.db.Exec(ctx, deleteSubmission, arg.TeamID, arg.Round)
+	return err
+}
+
+const deleteTeamSubmissions = `-- name: DeleteTeamSubmissions :exec
 DELETE FROM submission WHERE team_id = $1
 `
 
-func (q *Queries) DeleteSubmission(ctx context.Context, teamID uuid.UUID) error {
-	_, err := q.db.Exec(ctx, deleteSubmission, teamID)
+func (q *Queries) DeleteTeamSubmissions(ctx context.Context, teamID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteTeamSubmissions, teamID)
 	return err
 }
 
+const getSubmissionByRound = `-- name: GetSubmissionByRound :one
+SELECT id, title, description, track, github_link, figma_link, other_link, team_id, is_late, updated_at, round FROM submission
+WHERE team_id = $1 AND round = $2
+`
+
+type GetSubmissionByRoundParams struct {
+	TeamID uuid.UUID
+	Round  int32
+}
+
+func (q *Queries) GetSubmissionByRound(ctx context.Context, arg GetSubmissionByRoundParams) (Submission, error) {
+	row := q.db.QueryRow(ctx, getSubmissionByRound, arg.TeamID, arg.Round)
+	var i Submission
+	err := row.Scan(
+		&i.ID,
+		&i.Title,
+		&i.Description,
+		&i.Track,
+		&i.GithubLink,
+		&i.FigmaLink,
+		&i.OtherLink,
+		&i.TeamID,
+		&i.IsLate,
+		&i.UpdatedAt,
+		&i.Round,
+	)
+	return i, err
+}
+
 const getSubmissionByTeamID = `-- name: GetSubmissionByTeamID :one
-SELECT id, title, description, track, github_link, figma_link, other_link, team_id FROM submission WHERE team_id = $1
+SELECT id, title, description, track, github_link, figma_link, other_link, team_id, is_late, updated_at, round FROM submission
+WHERE team_id = $1
+ORDER BY round DESC
+LIMIT 1
 `
 
 func (q *Queries) GetSubmissionByTeamID(ctx context.Context, teamID uuid.UUID) (Submission, error) {
@@ -85,10 +140,137 @@ func (q *Queries) GetSubmissionByTeamID(ctx context.Context, teamID uuid.UUID) (
 		&i.FigmaLink,
 		&i.OtherLink,
 		&i.TeamID,
+		&i.IsLate,
+		&i.UpdatedAt,
+		&i.Round,
 	)
 	return i, err
 }
 
+const getSubmissionRequirements = `-- name: GetSubmissionRequirements :one
+SELECT required_fields FROM submission_requirements
+WHERE round = $1
+`
+
+func (q *Queries) GetSubmissionRequirements(ctx context.Context, round int32) ([]string, error) {
+	row := q.db.QueryRow(ctx, getSubmissionRequirements, round)
+	var required_fields []string
+	err := row.Scan(&required_fields)
+	return required_fields, err
+}
+
+const getSubmittedRepos = `-- name: GetSubmittedRepos :many
+SELECT DISTINCT ON (t.name, s.team_id) s.team_id, t.name, s.github_link
+FROM submission s
+JOIN teams t ON t.id = s.team_id
+ORDER BY t.name, s.team_id, s.round DESC
+`
+
+type GetSubmittedReposRow struct {
+	TeamID     uuid.UUID
+	Name       string
+	GithubLink string
+}
+
+func (q *Queries) GetSubmittedRepos(ctx context.Context) ([]GetSubmittedReposRow, error) {
+	rows, err := q.db.Query(ctx, getSubmittedRepos)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetSubmittedReposRow
+	for rows.Next() {
+		var i GetSubmittedReposRow
+		if err := rows.Scan(&i.TeamID, &i.Name, &i.GithubLink); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTeamSubmissions = `-- name: GetTeamSubmissions :many
+SELECT id, title, description, track, github_link, figma_link, other_link, team_id, is_late, updated_at, round FROM submission
+WHERE team_id = $1
+ORDER BY round
+`
+
+func (q *Queries) GetTeamSubmissions(ctx context.Context, teamID uuid.UUID) ([]Submission, error) {
+	rows, err := q.db.Query(ctx, getTeamSubmissions, teamID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Submission
+	for rows.Next() {
+		var i Submission
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Description,
+			&i.Track,
+			&i.GithubLink,
+			&i.FigmaLink,
+			&i.OtherLink,
+			&i.TeamID,
+			&i.IsLate,
+			&i.UpdatedAt,
+			&i.Round,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSubmissionRequirements = `-- name: ListSubmissionRequirements :many
+SELECT round, required_fields, updated_at FROM submission_requirements
+ORDER BY round
+`
+
+func (q *Queries) ListSubmissionRequirements(ctx context.Context) ([]SubmissionRequirement, error) {
+	rows, err := q.db.Query(ctx, listSubmissionRequirements)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SubmissionRequirement
+	for rows.Next() {
+		var i SubmissionRequirement
+		if err := rows.Scan(&i.Round, &i.RequiredFields, &i.UpdatedAt); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const transferSubmission = `-- name: TransferSubmission :exec
+UPDATE submission
+SET team_id = $1
+WHERE team_id = $2
+`
+
+type TransferSubmissionParams struct {
+	TargetTeamID uuid.UUID
+	SourceTeamID uuid.UUID
+}
+
+func (q *Queries) TransferSubmission(ctx context.Context, arg TransferSubmissionParams) error {
+	_, err := q.db.Exec(ctx, transferSubmission, arg.TargetTeamID, arg.SourceTeamID)
+	return err
+}
+
 const updateSubmission = `-- name: UpdateSubmission :one
 UPDATE submission
 SET github_link = $2,
@@ -96,9 +278,11 @@ SET github_link = $2,
     other_link = $4,
     title = $5,
     description = $6,
-    track = $7
-WHERE team_id = $1
-RETURNING id, title, description, track, github_link, figma_link, other_link, team_id
+    track = $7,
+    is_late = $8,
+    updated_at = CURRENT_TIMESTAMP
+WHERE team_id = $1 AND round = $9
+RETURNING id, title, description, track, github_link, figma_link, other_link, team_id, is_late, updated_at, round
 `
 
 type UpdateSubmissionParams struct {
@@ -109,6 +293,8 @@ type UpdateSubmissionParams struct {
 	Title       string
 	Description string
 	Track       string
+	IsLate      bool
+	Round       int32
 }
 
 func (q *Queries) UpdateSubmission(ctx context.Context, arg UpdateSubmissionParams) (Submission, error) {
@@ -120,6 +306,8 @@ func (q *Queries) UpdateSubmission(ctx context.Context, arg UpdateSubmissionPara
 		arg.Title,
 		arg.Description,
 		arg.Track,
+		arg.IsLate,
+		arg.Round,
 	)
 	var i Submission
 	err := row.Scan(
@@ -131,6 +319,30 @@ func (q *Queries) UpdateSubmission(ctx context.Context, arg UpdateSubmissionPara
 		&i.FigmaLink,
 		&i.OtherLink,
 		&i.TeamID,
+		&i.IsLate,
+		&i.UpdatedAt,
+		&i.Round,
 	)
 	return i, err
 }
+
+const upsertSubmissionRequirements = `-- name: UpsertSubmissionRequirements :one
+INSERT INTO submission_requirements (round, required_fields)
+VALUES ($1, $2)
+ON CONFLICT (round) DO UPDATE
+SET required_fields = EXCLUDED.required_fields,
+    updated_at = CURRENT_TIMESTAMP
+RETURNING round, required_fields, updated_at
+`
+
+type UpsertSubmissionRequirementsParams struct {
+	Round          int32
+	RequiredFields []string
+}
+
+func (q *Queries) UpsertSubmissionRequirements(ctx context.Context, arg UpsertSubmissionRequirementsParams) (SubmissionRequirement, error) {
+	row := q.db.QueryRow(ctx, upsertSubmissionRequirements, arg.Round, arg.RequiredFields)
+	var i SubmissionRequirement
+	err := row.Scan(&i.Round, &i.RequiredFields, &i.UpdatedAt)
+	return i, err
+}
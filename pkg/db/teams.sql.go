@@ -39,6 +39,17 @@ func (q *Queries) BanTeam(ctx context.Context, id uuid.UUID) error {
 	return err
 }
 
+const clearTeamMembers = `-- name: ClearTeamMembers :exec
+UPDATE users
+SET team_id = NULL, is_leader = FALSE
+WHERE team_id = $1
+`
+
+func (q *Queries) ClearTeamMembers(ctx context.Context, teamID uuid.NullUUID) error {
+	_, err := q.db.Exec(ctx, clearTeamMembers, teamID)
+	return err
+}
+
 const countTeamMembers = `-- name: CountTeamMembers :one
 SELECT COUNT(*) FROM users
 WHERE team_id = $1
@@ -57,7 +68,7 @@ INSERT INTO teams (
 ) VALUES (
     $1, $2, $3, $4, $5, $6
 )
-RETURNING id, name, number_of_people, round_qualified, code, is_banned
+RETURNING id, name, number_of_people, round_qualified, code, is_banned, tagline, description, avatar, repo_link, demo_link
 `
 
 type CreateTeamParams struct {
@@ -86,6 +97,11 @@ func (q *Queries) CreateTeam(ctx context.Context, arg CreateTeamParams) (Team, e
 		&i.RoundQualified,
 		&i.Code,
 		&i.IsBanned,
+		&i.Tagline,
+		&i.Description,
+		&i.Avatar,
+		&i.RepoLink,
+		&i.DemoLink,
 	)
 	return i, err
 }
@@ -136,14 +152,105 @@ func (q *Queries) FindTeam(ctx context.Context, code string) (FindTeamRow, error
 	return i, err
 }
 
+const getProjectGallery = `-- name: GetProjectGallery :many
+SELECT t.id, t.name, t.tagline, t.description, t.avatar, t.repo_link, t.demo_link, i.title, i.track
+FROM teams t
+LEFT JOIN ideas i ON i.team_id = t.id
+WHERE t.is_banned = FALSE
+  AND (t.tagline <> '' OR t.description <> '')
+  AND t.id > $1
+ORDER BY t.id
+LIMIT $2
+`
+
+type GetProjectGalleryParams struct {
+	ID    uuid.UUID
+	Limit int32
+}
+
+type GetProjectGalleryRow struct {
+	ID          uuid.UUID
+	Name        string
+	Tagline     string
+	Description string
+	Avatar      string
+	RepoLink    string
+	DemoLink    string
+	Title       *string
+	Track       *string
+}
+
+func (q *Queries) GetProjectGallery(ctx context.Context, arg GetProjectGalleryParams) ([]GetProjectGalleryRow, error) {
+	rows, err := q.db.Query(ctx, getProjectGallery, arg.ID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetProjectGalleryRow
+	for rows.Next() {
+		var i GetProjectGalleryRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Tagline,
+			&i.Description,
+			&i.Avatar,
+			&i.RepoLink,
+			&i.DemoLink,
+			&i.Title,
+			&i.Track,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getQualificationPool = `-- name: GetQualificationPool :many
+SELECT id, name, COALESCE(round_qualified, 0)::INTEGER AS round_qualified
+FROM teams
+WHERE NOT is_banned
+  AND GREATEST(COALESCE(round_qualified, 0), 1) >= $1::INTEGER
+ORDER BY name
+`
+
+type GetQualificationPoolRow struct {
+	ID             uuid.UUID
+	Name           string
+	RoundQualified int32
+}
+
+func (q *Queries) GetQualificationPool(ctx context.Context, round int32) ([]GetQualificationPoolRow, error) {
+	rows, err := q.db.Query(ctx, getQualificationPool, round)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetQualificationPoolRow
+	for rows.Next() {
+		var i GetQualificationPoolRow
+		if err := rows.Scan(&i.ID, &i.Name, &i.RoundQualified); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getTeamById = `-- name: GetTeamById :one
 SELECT teams.id, teams.name, teams.round_qualified, teams.code,teams.is_banned,
-       score.design, score.implementation, score.presentation, score.round,
        submission.title, submission.description, submission.track, submission.github_link, submission.figma_link, submission.other_link,
        ideas.title, ideas.description, ideas.track, ideas.is_selected
 FROM teams
-INNER JOIN score ON score.team_id = teams.id
 LEFT JOIN submission ON submission.team_id = teams.id
+    AND submission.round = GREATEST(COALESCE(teams.round_qualified, 0), 1)
 LEFT JOIN ideas ON ideas.team_id = teams.id
 WHERE teams.id = $1
 `
@@ -154,10 +261,6 @@ type GetTeamByIdRow struct {
 	RoundQualified pgtype.Int4
 	Code           string
 	IsBanned       bool
-	Design         int32
-	Implementation int32
-	Presentation   int32
-	Round          int32
 	Title          *string
 	Description    *string
 	Track          *string
@@ -179,10 +282,6 @@ func (q *Queries) GetTeamById(ctx context.Context, id uuid.UUID) (GetTeamByIdRow
 		&i.RoundQualified,
 		&i.Code,
 		&i.IsBanned,
-		&i.Design,
-		&i.Implementation,
-		&i.Presentation,
-		&i.Round,
 		&i.Title,
 		&i.Description,
 		&i.Track,
@@ -198,7 +297,7 @@ func (q *Queries) GetTeamById(ctx context.Context, id uuid.UUID) (GetTeamByIdRow
 }
 
 const getTeamByTeamId = `-- name: GetTeamByTeamId :one
-SELECT id, name, number_of_people, round_qualified, code, is_banned FROM teams WHERE id = $1
+SELECT id, name, number_of_people, round_qualified, code, is_banned, tagline, description, avatar, repo_link, demo_link FROM teams WHERE id = $1
 `
 
 func (q *Queries) GetTeamByTeamId(ctx context.Context, id uuid.UUID) (Team, error) {
@@ -211,12 +310,17 @@ func (q *Queries) GetTeamByTeamId(ctx context.Context, id uuid.UUID) (Team, erro
 		&i.RoundQualified,
 		&i.Code,
 		&i.IsBanned,
+		&i.Tagline,
+		&i.Description,
+		&i.Avatar,
+		&i.RepoLink,
+		&i.DemoLink,
 	)
 	return i, err
 }
 
 const getTeamByTrack = `-- name: GetTeamByTrack :many
-SELECT t.id, t.name, t.number_of_people, t.round_qualified, t.code, t.is_banned, i.title, i.description, i.track
+SELECT t.id, t.name, t.number_of_people, t.round_qualified, t.code, t.is_banned, t.tagline, t.description, t.avatar, t.repo_link, t.demo_link, i.title, i.description, i.track
 FROM teams t
 LEFT JOIN ideas i ON i.team_id = t.id
 WHERE i.track = $1
@@ -229,8 +333,13 @@ type GetTeamByTrackRow struct {
 	RoundQualified pgtype.Int4
 	Code           string
 	IsBanned       bool
+	Tagline        string
+	Description    string
+	Avatar         string
+	RepoLink       string
+	DemoLink       string
 	Title          *string
-	Description    *string
+	Description_2  *string
 	Track          *string
 }
 
@@ -250,8 +359,13 @@ func (q *Queries) GetTeamByTrack(ctx context.Context, track string) ([]GetTeamBy
 			&i.RoundQualified,
 			&i.Code,
 			&i.IsBanned,
-			&i.Title,
+			&i.Tagline,
 			&i.Description,
+			&i.Avatar,
+			&i.RepoLink,
+			&i.DemoLink,
+			&i.Title,
+			&i.Description_2,
 			&i.Track,
 		); err != nil {
 			return nil, err
@@ -375,7 +489,7 @@ func (q *Queries) GetTeamUsersEmails(ctx context.Context, teamID uuid.NullUUID)
 }
 
 const getTeams = `-- name: GetTeams :many
-SELECT teams.id, teams.name, teams.number_of_people, teams.round_qualified, teams.code, teams.is_banned,ideas.title,ideas.description,ideas.track
+SELECT teams.id, teams.name, teams.number_of_people, teams.round_qualified, teams.code, teams.is_banned, teams.tagline, teams.description, teams.avatar, teams.repo_link, teams.demo_link,ideas.title,ideas.description,ideas.track
 FROM teams
 LEFT JOIN ideas ON ideas.team_id = teams.id
 WHERE teams.name ILIKE '%' || $1 || '%'
@@ -397,8 +511,13 @@ type GetTeamsRow struct {
 	RoundQualified pgtype.Int4
 	Code           string
 	IsBanned       bool
+	Tagline        string
+	Description    string
+	Avatar         string
+	RepoLink       string
+	DemoLink       string
 	Title          *string
-	Description    *string
+	Description_2  *string
 	Track          *string
 }
 
@@ -418,8 +537,13 @@ func (q *Queries) GetTeams(ctx context.Context, arg GetTeamsParams) ([]GetTeamsR
 			&i.RoundQualified,
 			&i.Code,
 			&i.IsBanned,
-			&i.Title,
+			&i.Tagline,
 			&i.Description,
+			&i.Avatar,
+			&i.RepoLink,
+			&i.DemoLink,
+			&i.Title,
+			&i.Description_2,
 			&i.Track,
 		); err != nil {
 			return nil, err
@@ -432,6 +556,37 @@ func (q *Queries) GetTeams(ctx context.Context, arg GetTeamsParams) ([]GetTeamsR
 	return items, nil
 }
 
+const getTeamsUsersEmails = `-- name: GetTeamsUsersEmails :many
+SELECT team_id, email
+FROM users
+WHERE team_id = ANY($1::UUID[])
+`
+
+type GetTeamsUsersEmailsRow struct {
+	TeamID uuid.NullUUID
+	Email  string
+}
+
+func (q *Queries) GetTeamsUsersEmails(ctx context.Context, teamIds []uuid.UUID) ([]GetTeamsUsersEmailsRow, error) {
+	rows, err := q.db.Query(ctx, getTeamsUsersEmails, teamIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetTeamsUsersEmailsRow
+	for rows.Next() {
+		var i GetTeamsUsersEmailsRow
+		if err := rows.Scan(&i.TeamID, &i.Email); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getUserByID = `-- name: GetUserByID :one
 SELECT id, team_id, first_name, last_name, email, phone_no, gender, reg_no, github_profile, password, role, is_leader, is_verified, is_banned, is_profile_complete, is_starred, room_no, hostel_block FROM users WHERE id = $1
 `
@@ -474,7 +629,7 @@ func (q *Queries) IncreaseCountTeam(ctx context.Context, id uuid.UUID) error {
 }
 
 const infoQuery = `-- name: InfoQuery :many
-SELECT teams.id, name, number_of_people, round_qualified, code, teams.is_banned, users.id, team_id, first_name, last_name, email, phone_no, gender, reg_no, github_profile, password, role, is_leader, is_verified, users.is_banned, is_profile_complete, is_starred, room_no, hostel_block FROM teams INNER JOIN users ON users.team_id = teams.id WHERE teams.id = $1
+SELECT teams.id, name, number_of_people, round_qualified, code, teams.is_banned, tagline, description, avatar, repo_link, demo_link, users.id, team_id, first_name, last_name, email, phone_no, gender, reg_no, github_profile, password, role, is_leader, is_verified, users.is_banned, is_profile_complete, is_starred, room_no, hostel_block FROM teams INNER JOIN users ON users.team_id = teams.id WHERE teams.id = $1
 `
 
 type InfoQueryRow struct {
@@ -484,6 +639,11 @@ type InfoQueryRow struct {
 	RoundQualified    pgtype.Int4
 	Code              string
 	IsBanned          bool
+	Tagline           string
+	Description       string
+	Avatar            string
+	RepoLink          string
+	DemoLink          string
 	ID_2              uuid.UUID
 	TeamID            uuid.NullUUID
 	FirstName         string
@@ -520,6 +680,11 @@ func (q *Queries) InfoQuery(ctx context.Context, id uuid.UUID) ([]InfoQueryRow,
 			&i.RoundQualified,
 			&i.Code,
 			&i.IsBanned,
+			&i.Tagline,
+			&i.Description,
+			&i.Avatar,
+			&i.RepoLink,
+			&i.DemoLink,
 			&i.ID_2,
 			&i.TeamID,
 			&i.FirstName,
@@ -571,6 +736,49 @@ func (q *Queries) LeaveTeam(ctx context.Context, id uuid.UUID) error {
 	return err
 }
 
+const lockTeam = `-- name: LockTeam :exec
+SELECT id FROM teams
+WHERE id = $1
+FOR UPDATE
+`
+
+func (q *Queries) LockTeam(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, lockTeam, id)
+	return err
+}
+
+const moveTeamMembers = `-- name: MoveTeamMembers :exec
+UPDATE users
+SET team_id = $1, is_leader = FALSE
+WHERE team_id = $2
+`
+
+type MoveTeamMembersParams struct {
+	TargetTeamID uuid.NullUUID
+	SourceTeamID uuid.NullUUID
+}
+
+func (q *Queries) MoveTeamMembers(ctx context.Context, arg MoveTeamMembersParams) error {
+	_, err := q.db.Exec(ctx, moveTeamMembers, arg.TargetTeamID, arg.SourceTeamID)
+	return err
+}
+
+const promoteNextLeader = `-- name: PromoteNextLeader :exec
+UPDATE users
+SET is_leader = TRUE
+WHERE id = (
+    SELECT id FROM users
+    WHERE team_id = $1
+    ORDER BY id
+    LIMIT 1
+)
+`
+
+func (q *Queries) PromoteNextLeader(ctx context.Context, teamID uuid.NullUUID) error {
+	_, err := q.db.Exec(ctx, promoteNextLeader, teamID)
+	return err
+}
+
 const removeTeamIDFromUsers = `-- name: RemoveTeamIDFromUsers :exec
 UPDATE users
 SET team_id = NULL
@@ -598,6 +806,17 @@ func (q *Queries) RemoveUserFromTeam(ctx context.Context, arg RemoveUserFromTeam
 	return err
 }
 
+const syncTeamMemberCount = `-- name: SyncTeamMemberCount :exec
+UPDATE teams
+SET number_of_people = (SELECT COUNT(*) FROM users WHERE users.team_id = teams.id)
+WHERE teams.id = $1
+`
+
+func (q *Queries) SyncTeamMemberCount(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, syncTeamMemberCount, id)
+	return err
+}
+
 const unBanTeam = `-- name: UnBanTeam :exec
 UPDATE teams
 SET is_banned = FALSE
@@ -625,6 +844,22 @@ func (q *Queries) UpdateLeader(ctx context.Context, arg UpdateLeaderParams) erro
 	return err
 }
 
+const updateTeamAvatar = `-- name: UpdateTeamAvatar :exec
+UPDATE teams
+SET avatar = $1
+WHERE id = $2
+`
+
+type UpdateTeamAvatarParams struct {
+	Avatar string
+	ID     uuid.UUID
+}
+
+func (q *Queries) UpdateTeamAvatar(ctx context.Context, arg UpdateTeamAvatarParams) error {
+	_, err := q.db.Exec(ctx, updateTeamAvatar, arg.Avatar, arg.ID)
+	return err
+}
+
 const updateTeamName = `-- name: UpdateTeamName :exec
 UPDATE teams
 SET name = $1
@@ -641,6 +876,49 @@ func (q *Queries) UpdateTeamName(ctx context.Context, arg UpdateTeamNameParams)
 	return err
 }
 
+const updateTeamProfile = `-- name: UpdateTeamProfile :one
+UPDATE teams
+SET tagline = $2,
+    description = $3,
+    repo_link = $4,
+    demo_link = $5
+WHERE id = $1
+RETURNING id, name, number_of_people, round_qualified, code, is_banned, tagline, description, avatar, repo_link, demo_link
+`
+
+type UpdateTeamProfileParams struct {
+	ID          uuid.UUID
+	Tagline     string
+	Description string
+	RepoLink    string
+	DemoLink    string
+}
+
+func (q *Queries) UpdateTeamProfile(ctx context.Context, arg UpdateTeamProfileParams) (Team, error) {
+	row := q.db.QueryRow(ctx, updateTeamProfile,
+		arg.ID,
+		arg.Tagline,
+		arg.Description,
+		arg.RepoLink,
+		arg.DemoLink,
+	)
+	var i Team
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.NumberOfPeople,
+		&i.RoundQualified,
+		&i.Code,
+		&i.IsBanned,
+		&i.Tagline,
+		&i.Description,
+		&i.Avatar,
+		&i.RepoLink,
+		&i.DemoLink,
+	)
+	return i, err
+}
+
 const updateTeamRound = `-- name: UpdateTeamRound :exec
 UPDATE teams
 SET round_qualified = $1
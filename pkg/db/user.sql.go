@@ -253,6 +253,51 @@ func (q *Queries) GetAllVitians(ctx context.Context) ([]User, error) {
 	return items, nil
 }
 
+const getPanelUsers = `-- name: GetPanelUsers :many
+SELECT id, team_id, first_name, last_name, email, phone_no, gender, reg_no, github_profile, password, role, is_leader, is_verified, is_banned, is_profile_complete, is_starred, room_no, hostel_block FROM users
+WHERE role = 'panel' AND is_banned = FALSE
+ORDER BY email
+`
+
+func (q *Queries) GetPanelUsers(ctx context.Context) ([]User, error) {
+	rows, err := q.db.Query(ctx, getPanelUsers)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []User
+	for rows.Next() {
+		var i User
+		if err := rows.Scan(
+			&i.ID,
+			&i.TeamID,
+			&i.FirstName,
+			&i.LastName,
+			&i.Email,
+			&i.PhoneNo,
+			&i.Gender,
+			&i.RegNo,
+			&i.GithubProfile,
+			&i.Password,
+			&i.Role,
+			&i.IsLeader,
+			&i.IsVerified,
+			&i.IsBanned,
+			&i.IsProfileComplete,
+			&i.IsStarred,
+			&i.RoomNo,
+			&i.HostelBlock,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getTeamLeader = `-- name: GetTeamLeader :one
 SELECT id, team_id, first_name, last_name, email, phone_no, gender, reg_no, github_profile, password, role, is_leader, is_verified, is_banned, is_profile_complete, is_starred, room_no, hostel_block FROM users WHERE team_id = $1 AND is_leader = TRUE
 `
@@ -1,7 +1,59 @@
 package dto
 
+import (
+	"time"
+
+	"github.com/CodeChefVIT/devsoc-be-24/pkg/utils"
+)
+
 type Idea struct {
 	Title       string `json:"title"`
 	Description string `json:"description"`
 	Track       string `json:"track"`
+	Status      string `json:"status,omitempty"`
+	ReviewNote  string `json:"review_note,omitempty"`
+}
+
+type IdeaRevision struct {
+	Revision    int32     `json:"revision"`
+	Title       string    `json:"title"`
+	Description string    `json:"description"`
+	Track       string    `json:"track"`
+	Author      string    `json:"author"`
+	AuthorEmail string    `json:"author_email"`
+	CreatedAt   time.Time `json:"created_at"`
+	Changes     *IdeaDiff `json:"changes,omitempty"`
+}
+
+type IdeaDiff struct {
+	Title       []utils.DiffOp `json:"title"`
+	Description []utils.DiffOp `json:"description"`
+	Track       []utils.DiffOp `json:"track"`
+}
+
+type IdeaComment struct {
+	ID         string    `json:"id"`
+	Body       string    `json:"body"`
+	Internal   bool      `json:"internal"`
+	Author     string    `json:"author"`
+	AuthorRole string    `json:"author_role"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+type SimilarIdea struct {
+	IdeaID string `json:"idea_id"`
+	TeamID string `json:"team_id"`
+	Title  string `json:"title"`
+	Track  string `json:"track"`
+}
+
+type IdeaSimilarity struct {
+	A     string  `json:"a"`
+	B     string  `json:"b"`
+	Score float64 `json:"score"`
+}
+
+type IdeaCluster struct {
+	Ideas []SimilarIdea    `json:"ideas"`
+	Pairs []IdeaSimilarity `json:"pairs"`
 }
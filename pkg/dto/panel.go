@@ -0,0 +1,41 @@
+package dto
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type QueueEntry struct {
+	Round         int32  `json:"round"`
+	TeamID        string `json:"team_id"`
+	TeamName      string `json:"team_name"`
+	Track         string `json:"track"`
+	HasSubmission bool   `json:"has_submission"`
+	SubmissionURL string `json:"submission_url"`
+	Status        string `json:"status"`
+}
+
+type PanelLoad struct {
+	PanelID string         `json:"panel_id"`
+	Name    string         `json:"name"`
+	Email   string         `json:"email"`
+	Teams   int            `json:"teams"`
+	Tracks  map[string]int `json:"tracks"`
+}
+
+type ScoreAuditEntry struct {
+	ID        string          `json:"id"`
+	ScoreID   string          `json:"score_id"`
+	TeamID    string          `json:"team_id"`
+	TeamName  string          `json:"team_name"`
+	Round     int32           `json:"round"`
+	PanelID   string          `json:"panel_id,omitempty"`
+	Judge     string          `json:"judge,omitempty"`
+	ActorID   string          `json:"actor_id,omitempty"`
+	Actor     string          `json:"actor,omitempty"`
+	Action    string          `json:"action"`
+	Before    json.RawMessage `json:"before"`
+	After     json.RawMessage `json:"after"`
+	Reason    string          `json:"reason"`
+	CreatedAt time.Time       `json:"created_at"`
+}
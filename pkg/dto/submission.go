@@ -1,5 +1,7 @@
 package dto
 
+import "time"
+
 type Submission struct {
 	Title       string `json:"title"`
 	Description string `json:"description"`
@@ -8,4 +10,71 @@ type Submission struct {
 	FigmaLink   string `json:"figma_link"`
 	OtherLink   string `json:"other_link"`
 	TeamID      string `json:"team_id"`
+	IsLate      bool   `json:"is_late"`
+	Round       int32  `json:"round,omitempty"`
+	Version     int32  `json:"version,omitempty"`
+	Frozen      bool   `json:"frozen"`
+	HistoryURL  string `json:"history_url,omitempty"`
+}
+
+type SubmissionVersion struct {
+	Version    int32      `json:"version"`
+	Submission Submission `json:"submission"`
+	Author     string     `json:"author"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+type Attachment struct {
+	ID          string    `json:"id"`
+	FileName    string    `json:"file_name"`
+	ContentType string    `json:"content_type"`
+	Size        int64     `json:"size"`
+	DownloadURL string    `json:"download_url"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+type RepoContributor struct {
+	Login    string `json:"login"`
+	Commits  int    `json:"commits"`
+	IsMember bool   `json:"is_member"`
+}
+
+type RepoValidation struct {
+	Link           string            `json:"link"`
+	Repository     string            `json:"repository"`
+	Exists         bool              `json:"exists"`
+	Public         bool              `json:"public"`
+	Fork           bool              `json:"fork"`
+	WindowStart    *time.Time        `json:"window_start,omitempty"`
+	WindowEnd      *time.Time        `json:"window_end,omitempty"`
+	CommitCount    int               `json:"commit_count"`
+	FirstCommitAt  *time.Time        `json:"first_commit_at,omitempty"`
+	LastCommitAt   *time.Time        `json:"last_commit_at,omitempty"`
+	Contributors   []RepoContributor `json:"contributors"`
+	MissingMembers []string          `json:"missing_members"`
+	Issues         []string          `json:"issues"`
+	CheckedAt      time.Time         `json:"checked_at"`
+}
+
+type LargeCommit struct {
+	SHA         string    `json:"sha"`
+	Author      string    `json:"author"`
+	Additions   int       `json:"additions"`
+	Files       int       `json:"files"`
+	CommittedAt time.Time `json:"committed_at"`
+}
+
+type IntegrityReport struct {
+	TeamID              string            `json:"team_id"`
+	TeamName            string            `json:"team_name"`
+	Repository          string            `json:"repository"`
+	Error               string            `json:"error,omitempty"`
+	CommitCount         int               `json:"commit_count"`
+	HistoryTruncated    bool              `json:"history_truncated"`
+	CommitsBeforeStart  int               `json:"commits_before_start"`
+	FirstCommitAt       *time.Time        `json:"first_commit_at,omitempty"`
+	LargeInitialCommits []LargeCommit     `json:"large_initial_commits"`
+	OutsideContributors []RepoContributor `json:"outside_contributors"`
+	Flagged             bool              `json:"flagged"`
+	CheckedAt           time.Time         `json:"checked_at"`
 }
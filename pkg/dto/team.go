@@ -0,0 +1,24 @@
+package dto
+
+type TeamProfile struct {
+	ID          string `json:"team_id"`
+	Name        string `json:"team_name"`
+	Tagline     string `json:"tagline"`
+	Description string `json:"description"`
+	AvatarURL   string `json:"avatar_url"`
+	RepoLink    string `json:"repo_link"`
+	DemoLink    string `json:"demo_link"`
+	IdeaTitle   string `json:"idea_title,omitempty"`
+	Track       string `json:"track,omitempty"`
+}
+
+type QualificationEntry struct {
+	TeamID   string  `json:"team_id"`
+	TeamName string  `json:"team_name"`
+	Track    string  `json:"track"`
+	Rank     int     `json:"rank,omitempty"`
+	Total    float64 `json:"total"`
+	Scored   bool    `json:"scored"`
+	From     int32   `json:"from_round"`
+	To       int32   `json:"to_round"`
+}
@@ -0,0 +1,21 @@
+package models
+
+import "github.com/google/uuid"
+
+type PanelAssignment struct {
+	Round   int32       `json:"round" validate:"required,min=1"`
+	PanelID uuid.UUID   `json:"panel_id" validate:"required"`
+	TeamIDs []uuid.UUID `json:"team_ids" validate:"required,min=1"`
+}
+
+type AutoAssignPanels struct {
+	Round         int32 `json:"round" validate:"required,min=1"`
+	PanelsPerTeam int   `json:"panels_per_team" validate:"min=0"`
+	ReplaceManual bool  `json:"replace_manual"`
+}
+
+type PanelConflict struct {
+	TeamID uuid.UUID `json:"team_id"`
+	UserID uuid.UUID `json:"user_id"`
+	Reason string    `json:"reason" validate:"max=500"`
+}
@@ -1,7 +1,31 @@
 package models
 
+import "github.com/google/uuid"
+
+type CreateIdeaRequest struct {
+	Title       string `json:"title" validate:"required,min=3,max=100"`
+	Description string `json:"description" validate:"required,min=10,max=1000"`
+	Track       string `json:"track" validate:"required"`
+}
+
 type UpdateIdeaRequest struct {
 	Title       string `json:"title" validate:"required,min=3,max=100"`
 	Description string `json:"description" validate:"required,min=10,max=1000"`
 	Track       string `json:"track" validate:"required"`
 }
+
+type ReviewIdea struct {
+	Status string `json:"status" validate:"required,oneof=pending shortlisted selected rejected"`
+	Note   string `json:"note" validate:"max=1000"`
+}
+
+type BulkReviewIdeas struct {
+	IdeaIDs []uuid.UUID `json:"idea_ids" validate:"required,min=1"`
+	Status  string      `json:"status" validate:"required,oneof=pending shortlisted selected rejected"`
+	Note    string      `json:"note" validate:"max=1000"`
+}
+
+type IdeaComment struct {
+	Body     string `json:"body" validate:"required,max=2000"`
+	Internal bool   `json:"internal"`
+}
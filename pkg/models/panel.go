@@ -11,24 +11,19 @@ type CreatePanel struct {
 	Password      string `json:"password" validate:"required"`
 }
 
+// UpdateScore and CreateScore carry one scorecard: points keyed by the slug
+// of each rubric criterion configured for the round.
 type UpdateScore struct {
-	Design         int    `json:"design" validate:"min=0,max=10"`
-	Implementation int    `json:"implementation" validate:"min=0,max=10"`
-	Presentation   int    `json:"presentation" validate:"min=0,max=10"`
-	Round          int    `json:"round" validate:"required"`
-	Innovation     int    `json:"innovation" validate:"min=0,max=10"`
-	Teamwork       int    `json:"teamwork" validate:"min=0,max=10"`
-	Comment        string `json:"comment"`
-	TeamID         string `json:"team_id" validate:"uuid"`
+	Round   int            `json:"round" validate:"required,min=1"`
+	Scores  map[string]int `json:"scores" validate:"required"`
+	Comment string         `json:"comment"`
+	TeamID  string         `json:"team_id" validate:"uuid"`
+	Reason  string         `json:"reason" validate:"max=500"`
 }
 
 type CreateScore struct {
-	Design         int    `json:"design" validate:"min=0,max=10"`
-	Implementation int    `json:"implementation" validate:"min=0,max=10"`
-	Presentation   int    `json:"presentation" validate:"min=0,max=10"`
-	Round          int    `json:"round" validate:""`
-	Innovation     int    `json:"innovation" validate:"min=0,max=10"`
-	Teamwork       int    `json:"teamwork" validate:"min=0,max=10"`
-	Comment        string `json:"comment"`
-	TeamID         string `json:"team_id" validate:"required,uuid"`
+	Round   int            `json:"round" validate:"required,min=1"`
+	Scores  map[string]int `json:"scores" validate:"required"`
+	Comment string         `json:"comment"`
+	TeamID  string         `json:"team_id" validate:"required,uuid"`
 }
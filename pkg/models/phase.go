@@ -0,0 +1,28 @@
+package models
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const PhaseIdea = "idea"
+
+// SubmissionPhase names the submission window of a round.
+func SubmissionPhase(round int32) string {
+	return fmt.Sprintf("submission_round_%d", round)
+}
+
+type UpsertPhase struct {
+	Name         string    `json:"name" validate:"required"`
+	OpensAt      time.Time `json:"opens_at" validate:"required"`
+	ClosesAt     time.Time `json:"closes_at" validate:"required"`
+	GraceMinutes int32     `json:"grace_minutes" validate:"min=0"`
+}
+
+type PhaseOverride struct {
+	Phase  string    `json:"phase" validate:"required"`
+	TeamID uuid.UUID `json:"team_id" validate:"required"`
+	Until  time.Time `json:"until"`
+}
@@ -0,0 +1,22 @@
+package models
+
+type RubricCriterion struct {
+	Round       int32   `json:"round" validate:"required,min=1"`
+	Slug        string  `json:"slug" validate:"omitempty,max=50"`
+	Name        string  `json:"name" validate:"required,max=100"`
+	Description string  `json:"description" validate:"max=1000"`
+	Weight      float64 `json:"weight" validate:"gt=0"`
+	MaxPoints   int32   `json:"max_points" validate:"required,min=1,max=100"`
+	Position    int32   `json:"position" validate:"min=0"`
+}
+
+type JudgingRound struct {
+	Round         int32  `json:"round" validate:"required,min=1"`
+	Normalisation string `json:"normalisation" validate:"required,oneof=none zscore minmax"`
+}
+
+type JudgingRoundState struct {
+	Round  int32  `json:"round" validate:"required,min=1"`
+	State  string `json:"state" validate:"required,oneof=open locked published"`
+	Reason string `json:"reason" validate:"max=500"`
+}
@@ -1,32 +1,45 @@
 package models
 
-import "github.com/google/uuid"
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
 
 type GetScore struct {
-	Id             string `json:"id" validate:"required"`
-	Design         int    `json:"design" validate:"required,min=0,max=10"`
-	Implementation int    `json:"implementation" validate:"required,min=0,max=10"`
-	Presentation   int    `json:"presentation" validate:"required,min=0,max=10"`
-	Round          int    `json:"round" validate:"required"`
-	Innovation     int    `json:"innovation" validate:"required,min=0,max=10"`
-	Teamwork       int    `json:"teamwork" validate:"required,min=0,max=10"`
-	Comment        string `json:"comment"`
-	TeamID         string `json:"team_id" validate:"required,uuid"`
+	Id       string         `json:"id" validate:"required"`
+	Round    int            `json:"round" validate:"required"`
+	Criteria map[string]int `json:"criteria"`
+	Total    float64        `json:"total"`
+	PanelID  string         `json:"panel_id,omitempty"`
+	Judge    string         `json:"judge,omitempty"`
+	Comment  string         `json:"comment"`
+	TeamID   string         `json:"team_id" validate:"required,uuid"`
 }
 
 type Round struct {
-	Round          int `json:"round"`
-	Design         int `json:"design"`
-	Implementation int `json:"implementation"`
-	Presentation   int `json:"presentation"`
-	Innovation     int `json:"innovation"`
-	Teamwork       int `json:"teamwork"`
-	RoundTotal     int `json:"round_total"`
+	Round           int                `json:"round"`
+	Criteria        map[string]float64 `json:"criteria"`
+	RoundTotal      float64            `json:"round_total"`
+	NormalisedTotal float64            `json:"normalised_total"`
+	Normalisation   string             `json:"normalisation"`
+	Judges          int                `json:"judges"`
+	State           string             `json:"state"`
+	FinalisedAt     *time.Time         `json:"finalised_at,omitempty"`
 }
 
+// TeamLeaderboard is one ranked team. Rank is the competition rank (1, 2, 2,
+// 4) and DenseRank the dense rank (1, 2, 2, 3); teams share a rank only when
+// their normalised total and both tie-breakers are equal.
 type TeamLeaderboard struct {
-	TeamID       uuid.UUID `json:"team_id"`
-	TeamName     string  `json:"team_name"`
-	Rounds       []Round `json:"rounds"`
-	OverallTotal int     `json:"overall_total"`
-}
\ No newline at end of file
+	Rank              int       `json:"rank"`
+	DenseRank         int       `json:"dense_rank"`
+	TeamID            uuid.UUID `json:"team_id"`
+	TeamName          string    `json:"team_name"`
+	Track             string    `json:"track"`
+	Rounds            []Round   `json:"rounds"`
+	OverallTotal      float64   `json:"overall_total"`
+	OverallNormalised float64   `json:"overall_normalised"`
+	LatestRoundTotal  float64   `json:"latest_round_total"`
+	Innovation        float64   `json:"innovation"`
+}
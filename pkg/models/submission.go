@@ -5,7 +5,7 @@ type CreateSubmissionRequest struct {
 	Description string `json:"description" validate:"required"`
 	Track       string `json:"track" validate:"required"`
 	GithubLink  string `json:"github_link"`
-	FigmaLink   string `json:"figma_link`
+	FigmaLink   string `json:"figma_link"`
 	OtherLink   string `json:"other_link"`
 }
 
@@ -17,3 +17,35 @@ type UpdateSubmissionRequest struct {
 	FigmaLink   string `json:"figma_link"`
 	OtherLink   string `json:"other_link"`
 }
+
+type FreezeSubmissions struct {
+	Round int32 `json:"round" validate:"required,min=1"`
+}
+
+// SubmissionFields are the submission fields a round can make mandatory.
+// Title, description and track are always required by request validation,
+// so in practice rounds decide which of the links must be filled in.
+var SubmissionFields = []string{"title", "description", "track", "github_link", "figma_link", "other_link"}
+
+// DefaultRequiredFields apply to rounds without configured requirements.
+var DefaultRequiredFields = []string{"title", "description", "track"}
+
+type SubmissionRequirements struct {
+	Round          int32    `json:"round" validate:"required,min=1"`
+	RequiredFields []string `json:"required_fields" validate:"required,dive,oneof=title description track github_link figma_link other_link"`
+}
+
+func (r CreateSubmissionRequest) Fields() map[string]string {
+	return map[string]string{
+		"title":       r.Title,
+		"description": r.Description,
+		"track":       r.Track,
+		"github_link": r.GithubLink,
+		"figma_link":  r.FigmaLink,
+		"other_link":  r.OtherLink,
+	}
+}
+
+func (r UpdateSubmissionRequest) Fields() map[string]string {
+	return CreateSubmissionRequest(r).Fields()
+}
@@ -72,3 +72,43 @@ type TeamRoundQualified struct {
 	TeamId         uuid.UUID `json:"id" validate:"required"`
 	RoundQualified int       `json:"round_qualified" validate:"required"`
 }
+
+// QualifyTeams ranks the teams still in Round by their Round scores and
+// qualifies them for Round+1: the top N overall, the top N of each track, or
+// every team whose normalised total reaches Threshold.
+type QualifyTeams struct {
+	Round     int32   `json:"round" validate:"required,min=1"`
+	Mode      string  `json:"mode" validate:"required,oneof=top track threshold"`
+	TopN      int     `json:"top_n" validate:"min=0"`
+	Threshold float64 `json:"threshold"`
+	Aggregate string  `json:"aggregate" validate:"omitempty,oneof=mean median"`
+}
+
+type AdminTeamMember struct {
+	TeamID uuid.UUID `json:"team_id" validate:"required"`
+	UserID uuid.UUID `json:"user_id" validate:"required"`
+}
+
+type MergeTeams struct {
+	TargetTeamID   uuid.UUID `json:"target_team_id" validate:"required"`
+	SourceTeamID   uuid.UUID `json:"source_team_id" validate:"required"`
+	KeepIdea       string    `json:"keep_idea" validate:"required,oneof=target source"`
+	KeepSubmission string    `json:"keep_submission" validate:"required,oneof=target source"`
+}
+
+type DisbandTeam struct {
+	TeamID uuid.UUID `json:"team_id" validate:"required"`
+	Reason string    `json:"reason"`
+}
+
+type UpdateTeamProfile struct {
+	Tagline     string `json:"tagline" validate:"max=100"`
+	Description string `json:"description" validate:"max=1000"`
+	RepoLink    string `json:"repo_link" validate:"omitempty,url"`
+	DemoLink    string `json:"demo_link" validate:"omitempty,url"`
+}
+
+type AdminRenameTeam struct {
+	TeamID uuid.UUID `json:"team_id" validate:"required"`
+	Name   string    `json:"name" validate:"required,max=50"`
+}
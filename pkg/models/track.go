@@ -0,0 +1,10 @@
+package models
+
+type Track struct {
+	Slug           string `json:"slug" validate:"omitempty,max=50"`
+	Name           string `json:"name" validate:"required,max=100"`
+	Description    string `json:"description" validate:"max=1000"`
+	Capacity       int32  `json:"capacity" validate:"min=0"`
+	Sponsor        string `json:"sponsor" validate:"max=100"`
+	SelectionQuota int32  `json:"selection_quota" validate:"min=0"`
+}
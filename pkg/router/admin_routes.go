@@ -32,8 +32,56 @@ func AdminRoutes(incomingRoutes *echo.Group) {
 
 	admin.GET("/usercsv", controller.ExportUsers)
 	admin.GET("/teamcsv", controller.ExportTeams)
+	admin.GET("/integritycsv", controller.ExportIntegrity)
+	admin.GET("/scorecsv", controller.ExportScores)
+	admin.GET("/integrity", controller.GetIntegrityReports)
+	admin.GET("/integrity/:teamId", controller.GetTeamIntegrityReport)
 	admin.PUT("/team/rounds", controller.UpdateTeamRounds)
+	admin.POST("/qualification/preview", controller.PreviewQualification)
+	admin.POST("/qualification/apply", controller.ApplyQualification)
+	admin.POST("/submissions/freeze", controller.FreezeSubmissions)
+	admin.GET("/submissions/requirements", controller.ListSubmissionRequirements)
+	admin.PUT("/submissions/requirements", controller.UpsertSubmissionRequirements)
+	admin.POST("/team/member/add", controller.AdminAddTeamMember)
+	admin.POST("/team/member/remove", controller.AdminRemoveTeamMember)
+	admin.POST("/team/merge", controller.MergeTeams)
+	admin.POST("/team/disband", controller.DisbandTeam)
+	admin.GET("/audit", controller.GetAuditLogs)
+	admin.GET("/audit/scores", controller.GetScoreAudit)
+	admin.PUT("/team/name", controller.AdminRenameTeam)
+	admin.GET("/moderation/names", controller.GetFlaggedTeamNames)
+	admin.POST("/moderation/names/:id/approve", controller.ApproveTeamName)
+
+	admin.GET("/phases", controller.GetPhases)
+	admin.PUT("/phases", controller.UpsertPhase)
+	admin.GET("/phases/:phase/overrides", controller.GetPhaseOverrides)
+	admin.POST("/phases/override", controller.GrantPhaseOverride)
+	admin.DELETE("/phases/override", controller.RevokePhaseOverride)
+
+	admin.POST("/tracks", controller.CreateTrack)
+	admin.PUT("/tracks/:slug", controller.UpdateTrack)
+	admin.DELETE("/tracks/:slug", controller.DeleteTrack)
+
+	admin.GET("/rubric", controller.GetRubric)
+	admin.POST("/rubric", controller.CreateRubricCriterion)
+	admin.PUT("/rubric/:id", controller.UpdateRubricCriterion)
+	admin.DELETE("/rubric/:id", controller.DeleteRubricCriterion)
+	admin.GET("/judging/rounds", controller.ListJudgingRounds)
+	admin.PUT("/judging/rounds", controller.UpsertJudgingRound)
+	admin.PUT("/judging/rounds/state", controller.SetJudgingRoundState)
+
+	admin.GET("/assignments", controller.GetPanelAssignments)
+	admin.POST("/assignments", controller.AssignPanel)
+	admin.DELETE("/assignments", controller.UnassignPanel)
+	admin.POST("/assignments/auto", controller.AutoAssignPanels)
+	admin.GET("/conflicts", controller.ListConflicts)
 
 	admin.GET("/ideas", controller.GetAllIdeas)
 	admin.GET("/ideas/filter", controller.GetIdeasByTrack)
+	admin.GET("/ideas/duplicates", controller.GetDuplicateIdeas)
+	admin.GET("/ideas/:id/history", controller.GetIdeaHistoryAdmin)
+	admin.POST("/ideas/review", controller.BulkReviewIdeas)
+	admin.POST("/ideas/:id/review", controller.ReviewIdea)
+	admin.GET("/ideas/:id/comments", controller.GetIdeaComments)
+	admin.POST("/ideas/:id/comments", controller.AddIdeaComment)
 }
@@ -0,0 +1,13 @@
+package router
+
+import (
+	"github.com/CodeChefVIT/devsoc-be-24/pkg/controller"
+	"github.com/labstack/echo/v4"
+)
+
+func GalleryRoutes(incomingRoutes *echo.Group) {
+	gallery := incomingRoutes.Group("/gallery")
+
+	gallery.GET("", controller.GetProjectGallery)
+	gallery.GET("/avatar/:id", controller.GetTeamAvatar)
+}
@@ -3,6 +3,7 @@ package router
 import (
 	"github.com/CodeChefVIT/devsoc-be-24/pkg/controller"
 	"github.com/CodeChefVIT/devsoc-be-24/pkg/middleware"
+	"github.com/CodeChefVIT/devsoc-be-24/pkg/models"
 	"github.com/labstack/echo/v4"
 )
 
@@ -12,7 +13,10 @@ func IdeaRoutes(incomingRoutes *echo.Group) {
 	idea.Use(middleware.CheckTeamBan)
 	idea.Use(middleware.CheckUserVerifiation)
 
-	// idea.POST("/create", controller.CreateIdea)
-	// idea.PUT("/update", controller.UpdateIdea)
+	idea.POST("/create", controller.CreateIdea, middleware.CheckPhaseOpen(models.PhaseIdea))
+	idea.PUT("/update", controller.UpdateIdea, middleware.CheckPhaseOpen(models.PhaseIdea))
 	idea.GET("/", controller.GetIdea)
+	idea.GET("/history", controller.GetIdeaHistory)
+	idea.GET("/comments", controller.GetTeamIdeaComments)
+	idea.POST("/comments", controller.AddTeamIdeaComment)
 }
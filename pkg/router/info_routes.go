@@ -14,4 +14,5 @@ func InfoRoutes(incomingRoutes *echo.Group) {
 
 	info.GET("/me", controller.GetDetails)
 	info.POST("/me", controller.UpdateUser)
+	info.GET("/phases", controller.GetPhases)
 }
@@ -15,5 +15,16 @@ func PanelRoutes(incomingRoutes *echo.Group) {
 	panel.DELETE("/deletescore/:id", controller.DeleteScore)
 	panel.GET("/getscore/:teamid", controller.GetScore)
 	panel.PUT("/updatescore/:id", controller.UpdateScore)
+	panel.GET("/rubric", controller.GetRubric)
+	panel.GET("/queue", controller.GetPanelQueue)
+	panel.GET("/conflicts", controller.GetPanelConflicts)
+	panel.POST("/conflicts", controller.DeclarePanelConflict)
+	panel.DELETE("/conflicts/:id", controller.DeletePanelConflict)
 	panel.GET("/getsubmission/:teamId", controller.GetSubmission)
+	panel.GET("/submission/:teamId/history", controller.GetSubmissionHistory)
+	panel.GET("/submission/:teamId/attachments", controller.GetTeamAttachments)
+	panel.GET("/submission/:teamId/repo", controller.GetRepoValidation)
+	panel.GET("/attachments/:id", controller.DownloadAttachment)
+	panel.GET("/ideas/:id/comments", controller.GetIdeaComments)
+	panel.POST("/ideas/:id/comments", controller.AddIdeaComment)
 }
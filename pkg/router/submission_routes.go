@@ -12,8 +12,14 @@ func SubmissionRoutes(incomingRoutes *echo.Group) {
 	submission.Use(middleware.CheckTeamBan)
 	submission.Use(middleware.CheckUserVerifiation)
 
-	submission.POST("/create", controller.CreateSubmission)
+	submission.POST("/create", controller.CreateSubmission, middleware.CheckSubmissionWindow)
 	submission.GET("/get", controller.GetUserSubmission)
-	submission.POST("/update", controller.UpdateSubmission)
-	submission.DELETE("/delete", controller.DeleteSubmission)
+	submission.GET("/requirements", controller.GetSubmissionRequirements)
+	submission.GET("/history", controller.GetUserSubmissionHistory)
+	submission.GET("/repo", controller.GetUserRepoValidation)
+	submission.POST("/update", controller.UpdateSubmission, middleware.CheckSubmissionWindow)
+	submission.DELETE("/delete", controller.DeleteSubmission, middleware.CheckSubmissionWindow)
+	submission.POST("/attachments", controller.UploadSubmissionAttachment, middleware.CheckSubmissionWindow)
+	submission.GET("/attachments", controller.GetUserAttachments)
+	submission.DELETE("/attachments/:id", controller.DeleteSubmissionAttachment, middleware.CheckSubmissionWindow)
 }
@@ -20,5 +20,7 @@ func TeamRoutes(incomingRoutes *echo.Group) {
 	team.POST("/kick", controller.KickMemeber)
 	team.POST("/delete", controller.DeleteTeam)
 	team.PUT("/update", controller.UpdateTeamName)
+	team.PUT("/profile", controller.UpdateTeamProfile)
+	team.POST("/avatar", controller.UploadTeamAvatar)
 	team.GET("/users", controller.GetAllTeamUsers)
 }
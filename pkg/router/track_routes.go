@@ -0,0 +1,12 @@
+package router
+
+import (
+	"github.com/CodeChefVIT/devsoc-be-24/pkg/controller"
+	"github.com/labstack/echo/v4"
+)
+
+func TrackRoutes(incomingRoutes *echo.Group) {
+	tracks := incomingRoutes.Group("/tracks")
+
+	tracks.GET("", controller.GetTracks)
+}
@@ -2,6 +2,8 @@ package utils
 
 import (
 	"fmt"
+	"time"
+
 	"github.com/caarlos0/env/v11"
 	"github.com/joho/godotenv"
 )
@@ -12,26 +14,37 @@ type smtpcreds struct {
 }
 
 type cfg struct {
-	Port             string      `env:"PORT" envDefault:"8080"`
-	JwtSecret        string      `env:"JWT_SECRET,notEmpty"`
-	PostgresHost     string      `env:"POSTGRES_HOST,notEmpty"`
-	PostgresPort     string      `env:"POSTGRES_PORT,notEmpty"`
-	PostgresUser     string      `env:"POSTGRES_USER,notEmpty"`
-	PostgresPassword string      `env:"POSTGRES_PASSWORD,notEmpty"`
-	PostgresDB       string      `env:"POSTGRES_DB,notEmpty"`
-	RedisHost        string      `env:"REDIS_HOST,notEmpty"`
-	RedisPort        string      `env:"REDIS_PORT,notEmpty"`
-	RedisPassword    string      `env:"REDIS_PASSWORD,notEmpty"`
-	EmailHost        string      `env:"EMAIL_HOST,notEmpty"`
-	EmailPort        int         `env:"EMAIL_PORT,notEmpty"`
-	SmtpCreds        []smtpcreds `envPrefix:"MAIL"`
-	SendingEmail     string      `env:"SENDING_EMAIL,notEmpty"`
-	RepoOwner        string      `env:"REPO_OWNER,notEmpty"`
-	RepoName         string      `env:"REPO_NAME,notEmpty"`
-	Recipients       string      `env:"RECIPIENETS"`
-	CookieSecure     bool        `env:"SECURE" envDefault:"false"`
-	Domain           string      `env:"DOMAIN" envDefault:".codechefvit.com"`
-	GithubPAT        string      `env:"GITHUB_PAT"`
+	Port              string      `env:"PORT" envDefault:"8080"`
+	JwtSecret         string      `env:"JWT_SECRET,notEmpty"`
+	PostgresHost      string      `env:"POSTGRES_HOST,notEmpty"`
+	PostgresPort      string      `env:"POSTGRES_PORT,notEmpty"`
+	PostgresUser      string      `env:"POSTGRES_USER,notEmpty"`
+	PostgresPassword  string      `env:"POSTGRES_PASSWORD,notEmpty"`
+	PostgresDB        string      `env:"POSTGRES_DB,notEmpty"`
+	RedisHost         string      `env:"REDIS_HOST,notEmpty"`
+	RedisPort         string      `env:"REDIS_PORT,notEmpty"`
+	RedisPassword     string      `env:"REDIS_PASSWORD,notEmpty"`
+	EmailHost         string      `env:"EMAIL_HOST,notEmpty"`
+	EmailPort         int         `env:"EMAIL_PORT,notEmpty"`
+	SmtpCreds         []smtpcreds `envPrefix:"MAIL"`
+	SendingEmail      string      `env:"SENDING_EMAIL,notEmpty"`
+	RepoOwner         string      `env:"REPO_OWNER,notEmpty"`
+	RepoName          string      `env:"REPO_NAME,notEmpty"`
+	Recipients        string      `env:"RECIPIENETS"`
+	CookieSecure      bool        `env:"SECURE" envDefault:"false"`
+	Domain            string      `env:"DOMAIN" envDefault:".codechefvit.com"`
+	GithubPAT         string      `env:"GITHUB_PAT"`
+	GithubAPIURL      string      `env:"GITHUB_API_URL" envDefault:"https://api.github.com"`
+	HackathonStart    time.Time   `env:"HACKATHON_START"`
+	HackathonEnd      time.Time   `env:"HACKATHON_END"`
+	LargeCommitLines  int         `env:"LARGE_COMMIT_LINES" envDefault:"5000"`
+	UploadDir         string      `env:"UPLOAD_DIR" envDefault:"uploads"`
+	StorageDriver     string      `env:"STORAGE_DRIVER" envDefault:"local"`
+	AttachmentMaxSize int64       `env:"ATTACHMENT_MAX_SIZE" envDefault:"52428800"`
+	AttachmentQuota   int64       `env:"ATTACHMENT_TEAM_QUOTA" envDefault:"209715200"`
+	BlockedTeamWords  []string    `env:"BLOCKED_TEAM_WORDS" envSeparator:","`
+	ReservedTeamNames []string    `env:"RESERVED_TEAM_NAMES" envSeparator:"," envDefault:"admin,administrator,codechef,codechefvit,devsoc,organiser,organizer,official,panel,judge"`
+	ConflictRegNoLen  int32       `env:"CONFLICT_REGNO_PREFIX_LEN" envDefault:"5"`
 }
 
 var Config cfg
@@ -11,6 +11,7 @@ import (
 )
 
 var Queries *db.Queries
+var DB *pgxpool.Pool
 
 func InitDB() {
 	dbHost := os.Getenv("POSTGRES_HOST")
@@ -41,6 +42,7 @@ func InitDB() {
 	}
 
 	logger.Infof("Connected to the postgres successfully")
+	DB = pool
 	Queries = db.New(pool)
 	Ping(pool)
 }
@@ -0,0 +1,61 @@
+package utils
+
+import "strings"
+
+type DiffOp struct {
+	Op   string `json:"op"`
+	Text string `json:"text"`
+}
+
+// DiffWords returns a word-level diff turning a into b, with consecutive words
+// of the same kind merged into one op.
+func DiffWords(a, b string) []DiffOp {
+	x, y := strings.Fields(a), strings.Fields(b)
+
+	lcs := make([][]int, len(x)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(y)+1)
+	}
+	for i := len(x) - 1; i >= 0; i-- {
+		for j := len(y) - 1; j >= 0; j-- {
+			if x[i] == y[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	var ops []DiffOp
+	push := func(op, word string) {
+		if n := len(ops); n > 0 && ops[n-1].Op == op {
+			ops[n-1].Text += " " + word
+			return
+		}
+		ops = append(ops, DiffOp{Op: op, Text: word})
+	}
+
+	i, j := 0, 0
+	for i < len(x) && j < len(y) {
+		switch {
+		case x[i] == y[j]:
+			push("equal", x[i])
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			push("delete", x[i])
+			i++
+		default:
+			push("insert", y[j])
+			j++
+		}
+	}
+	for ; i < len(x); i++ {
+		push("delete", x[i])
+	}
+	for ; j < len(y); j++ {
+		push("insert", y[j])
+	}
+
+	return ops
+}
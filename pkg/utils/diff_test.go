@@ -0,0 +1,60 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDiffWords(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string
+		want []DiffOp
+	}{
+		{name: "both empty", a: "", b: "", want: nil},
+		{name: "whitespace only", a: "  \n\t", b: " ", want: nil},
+		{name: "from empty", a: "", b: "smart campus", want: []DiffOp{{Op: "insert", Text: "smart campus"}}},
+		{name: "to empty", a: "smart campus", b: "", want: []DiffOp{{Op: "delete", Text: "smart campus"}}},
+		{name: "identical", a: "a smart campus app", b: "a smart campus app", want: []DiffOp{{Op: "equal", Text: "a smart campus app"}}},
+		{name: "spacing ignored", a: "a  smart\ncampus", b: "a smart campus", want: []DiffOp{{Op: "equal", Text: "a smart campus"}}},
+		{
+			name: "replaced word",
+			a:    "a smart campus app",
+			b:    "a smart hostel app",
+			want: []DiffOp{
+				{Op: "equal", Text: "a smart"},
+				{Op: "delete", Text: "campus"},
+				{Op: "insert", Text: "hostel"},
+				{Op: "equal", Text: "app"},
+			},
+		},
+		{
+			name: "appended words",
+			a:    "food delivery",
+			b:    "food delivery for hostels",
+			want: []DiffOp{
+				{Op: "equal", Text: "food delivery"},
+				{Op: "insert", Text: "for hostels"},
+			},
+		},
+		{
+			name: "unicode",
+			a:    "café naïve 日本 app",
+			b:    "café naive 日本 app",
+			want: []DiffOp{
+				{Op: "equal", Text: "café"},
+				{Op: "delete", Text: "naïve"},
+				{Op: "insert", Text: "naive"},
+				{Op: "equal", Text: "日本 app"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DiffWords(tt.a, tt.b); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DiffWords(%q, %q) = %+v, want %+v", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,248 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+var ErrRepoNotFound = errors.New("repository not found")
+
+// maxCommitPages caps how many pages of commits are fetched for a single
+// repository so one huge repo cannot exhaust the API rate limit.
+const maxCommitPages = 10
+
+// maxContributorPages caps the pages of contributors fetched the same way.
+const maxContributorPages = 5
+
+// GithubCommitLimit is the most commits ListCommits returns; a result of this
+// length may be missing the oldest history.
+const GithubCommitLimit = maxCommitPages * 100
+
+type GithubClient struct {
+	BaseURL string
+	Token   string
+	HTTP    *http.Client
+}
+
+type GithubRepo struct {
+	FullName      string    `json:"full_name"`
+	Private       bool      `json:"private"`
+	Fork          bool      `json:"fork"`
+	DefaultBranch string    `json:"default_branch"`
+	CreatedAt     time.Time `json:"created_at"`
+	PushedAt      time.Time `json:"pushed_at"`
+}
+
+type GithubCommit struct {
+	SHA    string
+	Login  string
+	Author string
+	Date   time.Time
+}
+
+type GithubCommitStats struct {
+	Additions int
+	Deletions int
+	Files     int
+}
+
+type GithubContributor struct {
+	Login         string `json:"login"`
+	Contributions int    `json:"contributions"`
+}
+
+var Github *GithubClient
+
+func InitGithub() {
+	Github = NewGithubClient(Config.GithubAPIURL, Config.GithubPAT)
+}
+
+func NewGithubClient(baseURL, token string) *GithubClient {
+	return &GithubClient{
+		BaseURL: strings.TrimRight(baseURL, "/"),
+		Token:   token,
+		HTTP:    &http.Client{Timeout: 15 * time.Second},
+	}
+}
+
+// ParseGithubRepo extracts the owner and repository name from a github.com
+// link such as https://github.com/owner/repo.git or github.com/owner/repo/tree/main.
+func ParseGithubRepo(link string) (string, string, error) {
+	link = strings.TrimSpace(link)
+	if !strings.Contains(link, "://") {
+		link = "https://" + link
+	}
+
+	u, err := url.Parse(link)
+	if err != nil {
+		return "", "", err
+	}
+
+	host := strings.TrimPrefix(strings.ToLower(u.Host), "www.")
+	if host != "github.com" {
+		return "", "", fmt.Errorf("%q is not a github.com link", u.Host)
+	}
+
+	parts := strings.Split(strings.Trim(u.Path, "/"), "/")
+	if len(parts) < 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", errors.New("link does not point to a repository")
+	}
+
+	return parts[0], strings.TrimSuffix(parts[1], ".git"), nil
+}
+
+// GithubLogin returns the username from a profile link, or the input itself
+// when it is already a bare username.
+func GithubLogin(profile string) string {
+	profile = strings.TrimSpace(profile)
+	if i := strings.Index(strings.ToLower(profile), "github.com/"); i >= 0 {
+		profile = profile[i+len("github.com/"):]
+	}
+	login, _, _ := strings.Cut(strings.Trim(profile, "/@ "), "/")
+	return strings.ToLower(login)
+}
+
+func (g *GithubClient) get(ctx context.Context, path string, query url.Values, out any) error {
+	u := g.BaseURL + path
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Accept", "application/vnd.github.v3+json")
+	req.Header.Set("X-GitHub-Api-Version", "2022-11-28")
+	if g.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+g.Token)
+	}
+
+	resp, err := g.HTTP.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return ErrRepoNotFound
+	case resp.StatusCode == http.StatusNoContent:
+		return nil
+	case resp.StatusCode == http.StatusConflict:
+		// GitHub answers 409 for the commits of an empty repository.
+		return nil
+	case resp.StatusCode != http.StatusOK:
+		return fmt.Errorf("github returned %s for %s", resp.Status, path)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+func (g *GithubClient) GetRepo(ctx context.Context, owner, name string) (GithubRepo, error) {
+	var repo GithubRepo
+	err := g.get(ctx, fmt.Sprintf("/repos/%s/%s", owner, name), nil, &repo)
+	return repo, err
+}
+
+// ListCommits returns the commits on the default branch authored between
+// since and until; zero times leave that side of the range open.
+func (g *GithubClient) ListCommits(ctx context.Context, owner, name string, since, until time.Time) ([]GithubCommit, error) {
+	query := url.Values{"per_page": {"100"}}
+	if !since.IsZero() {
+		query.Set("since", since.UTC().Format(time.RFC3339))
+	}
+	if !until.IsZero() {
+		query.Set("until", until.UTC().Format(time.RFC3339))
+	}
+
+	var commits []GithubCommit
+	for page := 1; page <= maxCommitPages; page++ {
+		query.Set("page", fmt.Sprint(page))
+
+		var batch []struct {
+			SHA    string `json:"sha"`
+			Commit struct {
+				Author struct {
+					Name string    `json:"name"`
+					Date time.Time `json:"date"`
+				} `json:"author"`
+			} `json:"commit"`
+			Author *struct {
+				Login string `json:"login"`
+			} `json:"author"`
+		}
+
+		if err := g.get(ctx, fmt.Sprintf("/repos/%s/%s/commits", owner, name), query, &batch); err != nil {
+			return nil, err
+		}
+
+		for _, c := range batch {
+			commit := GithubCommit{
+				SHA:    c.SHA,
+				Author: c.Commit.Author.Name,
+				Date:   c.Commit.Author.Date,
+			}
+			if c.Author != nil {
+				commit.Login = c.Author.Login
+			}
+			commits = append(commits, commit)
+		}
+
+		if len(batch) < 100 {
+			break
+		}
+	}
+
+	return commits, nil
+}
+
+// ListContributors returns the repository's contributors, most active first,
+// paging through them like ListCommits does.
+func (g *GithubClient) ListContributors(ctx context.Context, owner, name string) ([]GithubContributor, error) {
+	query := url.Values{"per_page": {"100"}}
+
+	var contributors []GithubContributor
+	for page := 1; page <= maxContributorPages; page++ {
+		query.Set("page", fmt.Sprint(page))
+
+		var batch []GithubContributor
+		if err := g.get(ctx, fmt.Sprintf("/repos/%s/%s/contributors", owner, name), query, &batch); err != nil {
+			return nil, err
+		}
+		contributors = append(contributors, batch...)
+
+		if len(batch) < 100 {
+			break
+		}
+	}
+
+	return contributors, nil
+}
+
+func (g *GithubClient) GetCommitStats(ctx context.Context, owner, name, sha string) (GithubCommitStats, error) {
+	var commit struct {
+		Stats struct {
+			Additions int `json:"additions"`
+			Deletions int `json:"deletions"`
+		} `json:"stats"`
+		Files []struct{} `json:"files"`
+	}
+
+	if err := g.get(ctx, fmt.Sprintf("/repos/%s/%s/commits/%s", owner, name, sha), nil, &commit); err != nil {
+		return GithubCommitStats{}, err
+	}
+
+	return GithubCommitStats{
+		Additions: commit.Stats.Additions,
+		Deletions: commit.Stats.Deletions,
+		Files:     len(commit.Files),
+	}, nil
+}
@@ -0,0 +1,156 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// Package variables are set before init parses Config, so the settings it
+// requires get placeholders here when the environment does not provide them.
+var _ = func() bool {
+	for key, value := range map[string]string{
+		"JWT_SECRET": "test", "POSTGRES_HOST": "localhost", "POSTGRES_PORT": "5432", "POSTGRES_USER": "test",
+		"POSTGRES_PASSWORD": "test", "POSTGRES_DB": "test", "REDIS_HOST": "localhost", "REDIS_PORT": "6379",
+		"REDIS_PASSWORD": "test", "EMAIL_HOST": "localhost", "EMAIL_PORT": "587", "SENDING_EMAIL": "test@example.com",
+		"REPO_OWNER": "test", "REPO_NAME": "test",
+	} {
+		if os.Getenv(key) == "" {
+			os.Setenv(key, value)
+		}
+	}
+	return true
+}()
+
+// pagedHandler serves total items of a listing in pages of per_page, the way
+// GitHub does, building each item with item.
+func pagedHandler(t *testing.T, total int, item func(i int) any) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		perPage, _ := strconv.Atoi(r.URL.Query().Get("per_page"))
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		if perPage != 100 || page < 1 {
+			t.Errorf("unexpected paging %q for %s", r.URL.RawQuery, r.URL.Path)
+		}
+
+		batch := []any{}
+		for i := (page - 1) * perPage; i < min(page*perPage, total); i++ {
+			batch = append(batch, item(i))
+		}
+		json.NewEncoder(w).Encode(batch)
+	}
+}
+
+func newTestGithub(t *testing.T) *GithubClient {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/repos/team/public", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Authorization"); got != "Bearer secret" {
+			t.Errorf("Authorization = %q, want the configured token", got)
+		}
+		json.NewEncoder(w).Encode(map[string]any{"full_name": "team/public", "private": false, "default_branch": "main"})
+	})
+	mux.HandleFunc("/repos/team/private", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]any{"full_name": "team/private", "private": true})
+	})
+	mux.HandleFunc("/repos/team/missing", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"message":"Not Found"}`, http.StatusNotFound)
+	})
+	mux.HandleFunc("/repos/team/empty/commits", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"message":"Git Repository is empty."}`, http.StatusConflict)
+	})
+
+	mux.HandleFunc("/repos/team/public/commits", pagedHandler(t, 130, func(i int) any {
+		commit := map[string]any{
+			"sha": fmt.Sprintf("sha%d", i),
+			"commit": map[string]any{
+				"author": map[string]any{"name": "Member", "date": "2025-02-01T10:00:00Z"},
+			},
+		}
+		// Commits whose email is not linked to an account have no author.
+		if i%2 == 0 {
+			commit["author"] = map[string]any{"login": "member"}
+		}
+		return commit
+	}))
+	mux.HandleFunc("/repos/team/public/contributors", pagedHandler(t, 205, func(i int) any {
+		return map[string]any{"login": fmt.Sprintf("user%d", i), "contributions": 300 - i}
+	}))
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return NewGithubClient(server.URL+"/", "secret")
+}
+
+func TestGithubGetRepo(t *testing.T) {
+	github := newTestGithub(t)
+	ctx := context.Background()
+
+	repo, err := github.GetRepo(ctx, "team", "public")
+	if err != nil {
+		t.Fatalf("GetRepo(public): %v", err)
+	}
+	if repo.FullName != "team/public" || repo.Private || repo.DefaultBranch != "main" {
+		t.Errorf("GetRepo(public) = %+v", repo)
+	}
+
+	repo, err = github.GetRepo(ctx, "team", "private")
+	if err != nil {
+		t.Fatalf("GetRepo(private): %v", err)
+	}
+	if !repo.Private {
+		t.Errorf("GetRepo(private) reported a public repository")
+	}
+
+	if _, err := github.GetRepo(ctx, "team", "missing"); !errors.Is(err, ErrRepoNotFound) {
+		t.Errorf("GetRepo(missing) error = %v, want ErrRepoNotFound", err)
+	}
+}
+
+func TestGithubListCommits(t *testing.T) {
+	github := newTestGithub(t)
+	ctx := context.Background()
+
+	commits, err := github.ListCommits(ctx, "team", "public", time.Time{}, time.Time{})
+	if err != nil {
+		t.Fatalf("ListCommits: %v", err)
+	}
+	if len(commits) != 130 {
+		t.Fatalf("ListCommits returned %d commits, want all 130 across both pages", len(commits))
+	}
+	if commits[0].SHA != "sha0" || commits[0].Login != "member" || commits[0].Author != "Member" {
+		t.Errorf("first commit = %+v", commits[0])
+	}
+	if commits[1].Login != "" {
+		t.Errorf("commit without an account has login %q", commits[1].Login)
+	}
+	if want := time.Date(2025, 2, 1, 10, 0, 0, 0, time.UTC); !commits[129].Date.Equal(want) {
+		t.Errorf("commit date = %v, want %v", commits[129].Date, want)
+	}
+
+	commits, err = github.ListCommits(ctx, "team", "empty", time.Time{}, time.Time{})
+	if err != nil || len(commits) != 0 {
+		t.Errorf("ListCommits(empty) = %d commits, %v; want none and no error", len(commits), err)
+	}
+}
+
+func TestGithubListContributors(t *testing.T) {
+	github := newTestGithub(t)
+
+	contributors, err := github.ListContributors(context.Background(), "team", "public")
+	if err != nil {
+		t.Fatalf("ListContributors: %v", err)
+	}
+	if len(contributors) != 205 {
+		t.Fatalf("ListContributors returned %d contributors, want all 205 across three pages", len(contributors))
+	}
+	if last := contributors[204]; last.Login != "user204" || last.Contributions != 96 {
+		t.Errorf("last contributor = %+v", last)
+	}
+}
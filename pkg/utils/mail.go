@@ -48,3 +48,11 @@ func SendEmail(to, subject, body string, attachments ...string) error {
 	logger.Infof("Sent mail using: " + dialer.Username)
 	return nil
 }
+
+func SendBulkEmail(emails []string, subject, body string) {
+	for _, email := range emails {
+		if err := SendEmail(email, subject, body); err != nil {
+			logger.Errorf("Unable to send %q to %s: %v", subject, email, err)
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+type TeamNameVerdict int
+
+const (
+	TeamNameAllowed TeamNameVerdict = iota
+	TeamNameFlagged
+	TeamNameRejected
+)
+
+var leetReplacer = strings.NewReplacer(
+	"0", "o",
+	"1", "i",
+	"3", "e",
+	"4", "a",
+	"5", "s",
+	"7", "t",
+	"8", "b",
+	"@", "a",
+	"$", "s",
+	"!", "i",
+	"|", "l",
+)
+
+// NormalizeTeamName folds case, undoes common leetspeak substitutions and
+// drops everything that is not a letter or digit, so "C0de Chef" and
+// "codechef" compare equal.
+func NormalizeTeamName(name string) string {
+	return strings.Join(teamNameTokens(name), "")
+}
+
+// teamNameTokens folds the name the way NormalizeTeamName does and splits it
+// into the runs of letters and digits it keeps.
+func teamNameTokens(name string) []string {
+	return strings.FieldsFunc(leetReplacer.Replace(strings.ToLower(name)), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// containsTokens reports whether a run of consecutive tokens spells word, so
+// "ass" matches "Bad Ass" but not "Class", while "Code Chef" still matches
+// "codechef".
+func containsTokens(tokens []string, word string) bool {
+	for i := range tokens {
+		joined := ""
+		for _, token := range tokens[i:] {
+			joined += token
+			if joined == word {
+				return true
+			}
+			if len(joined) >= len(word) {
+				break
+			}
+		}
+	}
+	return false
+}
+
+// CheckTeamName rejects names containing a blocked word or matching a reserved
+// name outright, and flags names that merely contain a reserved name for
+// review by an admin. Words only match whole tokens of the name.
+func CheckTeamName(name string) (TeamNameVerdict, string) {
+	tokens := teamNameTokens(name)
+	normalized := strings.Join(tokens, "")
+
+	for _, word := range Config.BlockedTeamWords {
+		blocked := NormalizeTeamName(word)
+		if blocked != "" && containsTokens(tokens, blocked) {
+			return TeamNameRejected, "contains a blocked word"
+		}
+	}
+
+	for _, word := range Config.ReservedTeamNames {
+		reserved := NormalizeTeamName(word)
+		if reserved == "" {
+			continue
+		}
+		if normalized == reserved {
+			return TeamNameRejected, "is reserved"
+		}
+		if containsTokens(tokens, reserved) {
+			return TeamNameFlagged, fmt.Sprintf("resembles reserved name %q", word)
+		}
+	}
+
+	return TeamNameAllowed, ""
+}
@@ -0,0 +1,12 @@
+package utils
+
+import "github.com/CodeChefVIT/devsoc-be-24/pkg/db"
+
+// CurrentRound is the round a team is competing in. Teams that have not
+// qualified for anything yet are in round 1.
+func CurrentRound(team db.Team) int32 {
+	if team.RoundQualified.Int32 < 1 {
+		return 1
+	}
+	return team.RoundQualified.Int32
+}
@@ -0,0 +1,160 @@
+package utils
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+var similarityStopWords = map[string]bool{
+	"a": true, "an": true, "and": true, "are": true, "as": true, "at": true,
+	"be": true, "by": true, "can": true, "for": true, "from": true, "in": true,
+	"into": true, "is": true, "it": true, "its": true, "of": true, "on": true,
+	"or": true, "that": true, "the": true, "their": true, "this": true,
+	"to": true, "we": true, "which": true, "will": true, "with": true,
+}
+
+type SimilarPair struct {
+	A, B  int
+	Score float64
+}
+
+// shingles splits text into lower-cased words without stop words and returns
+// the words together with every pair of adjacent words, so reordered copies
+// still share terms while verbatim copies share many more.
+func shingles(text string) []string {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	kept := words[:0]
+	for _, w := range words {
+		if !similarityStopWords[w] {
+			kept = append(kept, w)
+		}
+	}
+
+	terms := append([]string(nil), kept...)
+	for i := 0; i+1 < len(kept); i++ {
+		terms = append(terms, kept[i]+" "+kept[i+1])
+	}
+	return terms
+}
+
+// tfidf builds an L2-normalised TF-IDF vector for every document, using
+// sublinear term frequency and smoothed inverse document frequency.
+func tfidf(docs []string) []map[string]float64 {
+	counts := make([]map[string]int, len(docs))
+	df := map[string]int{}
+	for i, doc := range docs {
+		counts[i] = map[string]int{}
+		for _, term := range shingles(doc) {
+			if counts[i][term] == 0 {
+				df[term]++
+			}
+			counts[i][term]++
+		}
+	}
+
+	n := float64(len(docs))
+	vectors := make([]map[string]float64, len(docs))
+	for i, tf := range counts {
+		vec := make(map[string]float64, len(tf))
+		var norm float64
+		for term, c := range tf {
+			w := (1 + math.Log(float64(c))) * (math.Log((1+n)/(1+float64(df[term]))) + 1)
+			vec[term] = w
+			norm += w * w
+		}
+		norm = math.Sqrt(norm)
+		for term := range vec {
+			vec[term] /= norm
+		}
+		vectors[i] = vec
+	}
+	return vectors
+}
+
+// SimilarDocuments returns every pair of documents whose TF-IDF cosine
+// similarity is at least threshold, most similar first.
+func SimilarDocuments(docs []string, threshold float64) []SimilarPair {
+	vectors := tfidf(docs)
+
+	postings := map[string][]int{}
+	for i, vec := range vectors {
+		for term := range vec {
+			postings[term] = append(postings[term], i)
+		}
+	}
+
+	var pairs []SimilarPair
+	for i, vec := range vectors {
+		scores := map[int]float64{}
+		for term, w := range vec {
+			for _, j := range postings[term] {
+				if j > i {
+					scores[j] += w * vectors[j][term]
+				}
+			}
+		}
+		for j, score := range scores {
+			if score >= threshold {
+				pairs = append(pairs, SimilarPair{A: i, B: j, Score: math.Min(score, 1)})
+			}
+		}
+	}
+
+	sort.Slice(pairs, func(i, j int) bool {
+		if pairs[i].Score != pairs[j].Score {
+			return pairs[i].Score > pairs[j].Score
+		}
+		if pairs[i].A != pairs[j].A {
+			return pairs[i].A < pairs[j].A
+		}
+		return pairs[i].B < pairs[j].B
+	})
+	return pairs
+}
+
+// ClusterPairs groups documents connected by any pair into clusters. Documents
+// without a pair are left out.
+func ClusterPairs(pairs []SimilarPair) [][]int {
+	parent := map[int]int{}
+	var find func(int) int
+	find = func(x int) int {
+		if _, ok := parent[x]; !ok {
+			parent[x] = x
+		}
+		if parent[x] != x {
+			parent[x] = find(parent[x])
+		}
+		return parent[x]
+	}
+
+	for _, p := range pairs {
+		a, b := find(p.A), find(p.B)
+		if a != b {
+			parent[max(a, b)] = min(a, b)
+		}
+	}
+
+	groups := map[int][]int{}
+	for x := range parent {
+		root := find(x)
+		groups[root] = append(groups[root], x)
+	}
+
+	clusters := make([][]int, 0, len(groups))
+	for _, members := range groups {
+		sort.Ints(members)
+		clusters = append(clusters, members)
+	}
+	sort.Slice(clusters, func(i, j int) bool {
+		if len(clusters[i]) != len(clusters[j]) {
+			return len(clusters[i]) > len(clusters[j])
+		}
+		return clusters[i][0] < clusters[j][0]
+	})
+	return clusters
+}
@@ -0,0 +1,103 @@
+package utils
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestSimilarDocuments(t *testing.T) {
+	tests := []struct {
+		name      string
+		docs      []string
+		threshold float64
+		want      []SimilarPair
+	}{
+		{name: "no documents", docs: nil, threshold: 0.5, want: nil},
+		{name: "empty documents", docs: []string{"", "  ", ""}, threshold: 0, want: nil},
+		{name: "only stop words", docs: []string{"the and of", "the and of"}, threshold: 0.5, want: nil},
+		{
+			name:      "identical",
+			docs:      []string{"Campus food delivery by drones", "Campus food delivery by drones"},
+			threshold: 0.9,
+			want:      []SimilarPair{{A: 0, B: 1, Score: 1}},
+		},
+		{
+			name:      "case and punctuation ignored",
+			docs:      []string{"Campus food-delivery, by drones!", "campus FOOD delivery by drones"},
+			threshold: 0.9,
+			want:      []SimilarPair{{A: 0, B: 1, Score: 1}},
+		},
+		{
+			name:      "unicode",
+			docs:      []string{"Écoute la musique 日本", "écoute LA MUSIQUE 日本", "campus food delivery"},
+			threshold: 0.9,
+			want:      []SimilarPair{{A: 0, B: 1, Score: 1}},
+		},
+		{
+			name:      "unrelated",
+			docs:      []string{"campus food delivery", "blockchain voting system"},
+			threshold: 0.1,
+			want:      nil,
+		},
+		{
+			name: "most similar first",
+			docs: []string{
+				"hostel laundry booking app",
+				"blockchain voting system",
+				"hostel laundry booking app",
+				"laundry booking app for hostels",
+			},
+			threshold: 0.3,
+			want: []SimilarPair{
+				{A: 0, B: 2, Score: 1},
+				{A: 0, B: 3, Score: 0.5598},
+				{A: 2, B: 3, Score: 0.5598},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := SimilarDocuments(tt.docs, tt.threshold)
+			if len(got) != len(tt.want) {
+				t.Fatalf("SimilarDocuments = %+v, want %+v", got, tt.want)
+			}
+			for i, pair := range got {
+				want := tt.want[i]
+				if pair.A != want.A || pair.B != want.B || math.Abs(pair.Score-want.Score) > 1e-3 {
+					t.Errorf("pair %d = %+v, want %+v", i, pair, want)
+				}
+			}
+		})
+	}
+}
+
+func TestClusterPairs(t *testing.T) {
+	tests := []struct {
+		name  string
+		pairs []SimilarPair
+		want  [][]int
+	}{
+		{name: "no pairs", pairs: nil, want: [][]int{}},
+		{name: "one pair", pairs: []SimilarPair{{A: 2, B: 5}}, want: [][]int{{2, 5}}},
+		{
+			name:  "chained pairs merge",
+			pairs: []SimilarPair{{A: 0, B: 3}, {A: 4, B: 7}, {A: 3, B: 6}},
+			want:  [][]int{{0, 3, 6}, {4, 7}},
+		},
+		{
+			name:  "equal sizes ordered by first document",
+			pairs: []SimilarPair{{A: 5, B: 6}, {A: 1, B: 2}},
+			want:  [][]int{{1, 2}, {5, 6}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ClusterPairs(tt.pairs); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ClusterPairs(%+v) = %v, want %v", tt.pairs, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	logger "github.com/CodeChefVIT/devsoc-be-24/pkg/logging"
+)
+
+// Storage keeps uploaded files under opaque, slash separated keys.
+type Storage interface {
+	Save(ctx context.Context, key string, r io.Reader) (int64, error)
+	Open(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
+
+var Store Storage
+
+func InitStorage() {
+	switch Config.StorageDriver {
+	case "local":
+		Store = &LocalStorage{Root: Config.UploadDir}
+	default:
+		logger.Errorf("Unknown storage driver %q, falling back to local", Config.StorageDriver)
+		Store = &LocalStorage{Root: Config.UploadDir}
+	}
+}
+
+// LocalStorage stores files on the local filesystem below Root.
+type LocalStorage struct {
+	Root string
+}
+
+func (s *LocalStorage) path(key string) (string, error) {
+	clean := filepath.Clean(filepath.FromSlash(key))
+	if filepath.IsAbs(clean) || clean == ".." || strings.HasPrefix(clean, ".."+string(filepath.Separator)) {
+		return "", errors.New("invalid storage key")
+	}
+	return filepath.Join(s.Root, clean), nil
+}
+
+func (s *LocalStorage) Save(ctx context.Context, key string, r io.Reader) (int64, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return 0, err
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	n, err := io.Copy(f, r)
+	if err != nil {
+		os.Remove(path)
+		return 0, err
+	}
+	return n, nil
+}
+
+func (s *LocalStorage) Open(ctx context.Context, key string) (io.ReadCloser, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	return os.Open(path)
+}
+
+func (s *LocalStorage) Delete(ctx context.Context, key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,22 @@
+package utils
+
+import "strings"
+
+// TrackSlug turns a track name into its catalog slug: lower case, with every
+// run of characters outside a-z and 0-9 collapsed into a single hyphen. It
+// mirrors the mapping used when tracks were migrated, so "AI/ML" and "ai-ml"
+// resolve to the same track.
+func TrackSlug(name string) string {
+	var b strings.Builder
+	hyphen := false
+	for _, r := range strings.ToLower(name) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			b.WriteRune(r)
+			hyphen = false
+		} else if !hyphen && b.Len() > 0 {
+			b.WriteByte('-')
+			hyphen = true
+		}
+	}
+	return strings.TrimSuffix(b.String(), "-")
+}
@@ -30,6 +30,14 @@ func FormatValidationErrors(err error) string {
 				return fmt.Sprintf("%s field is invalid length", e.Field())
 			case "alphanum":
 				return fmt.Sprintf("%s field must contain only letters or numbers", e.Field())
+			case "min":
+				return fmt.Sprintf("%s field must be at least %s", e.Field(), e.Param())
+			case "max":
+				return fmt.Sprintf("%s field must be at most %s", e.Field(), e.Param())
+			case "gt":
+				return fmt.Sprintf("%s field must be greater than %s", e.Field(), e.Param())
+			case "oneof":
+				return fmt.Sprintf("%s field must be one of: %s", e.Field(), e.Param())
 			}
 		}
 	}